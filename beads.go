@@ -135,4 +135,5 @@ const (
 	EventLabelAdded        = types.EventLabelAdded
 	EventLabelRemoved      = types.EventLabelRemoved
 	EventCompacted         = types.EventCompacted
+	EventAssigneeChanged   = types.EventAssigneeChanged
 )
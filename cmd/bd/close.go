@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/hooks"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
@@ -23,7 +24,11 @@ var closeCmd = &cobra.Command{
 	Long: `Close one or more issues.
 
 If no issue ID is provided, closes the last touched issue (from most recent
-create, update, show, or close operation).`,
+create, update, show, or close operation).
+
+With close.auto-close-epics enabled, closing the last open child of an epic
+also closes the epic, and so on up through any enclosing epics. With --json,
+the output then becomes an object whose "auto_closed" key lists those epics.`,
 	Args: cobra.MinimumNArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
 		CheckReadonly("close")
@@ -104,6 +109,7 @@ create, update, show, or close operation).`,
 		// Direct mode
 		closedIssues := []*types.Issue{}
 		closedCount := 0
+		var autoClosedEpics []string
 
 		// Handle local IDs
 		for _, id := range resolvedIDs {
@@ -154,6 +160,7 @@ create, update, show, or close operation).`,
 
 			// Auto-close parent molecule if all steps are now complete
 			autoCloseCompletedMolecule(ctx, store, id, actor, session)
+			autoClosedEpics = append(autoClosedEpics, autoCloseCompletedEpics(ctx, store, id, actor, session)...)
 
 			// Run close hook (best effort: hook runs only if re-fetch succeeds)
 			closedIssue, _ := store.GetIssue(ctx, id)
@@ -225,6 +232,7 @@ create, update, show, or close operation).`,
 
 			// Auto-close parent molecule if all steps are now complete
 			autoCloseCompletedMolecule(ctx, result.Store, result.ResolvedID, actor, session)
+			autoClosedEpics = append(autoClosedEpics, autoCloseCompletedEpics(ctx, result.Store, result.ResolvedID, actor, session)...)
 
 			// Get updated issue for hook (best effort: hook runs only if re-fetch succeeds)
 			closedIssue, _ := result.Store.GetIssue(ctx, result.ResolvedID)
@@ -247,10 +255,14 @@ create, update, show, or close operation).`,
 			unblocked, err := store.GetNewlyUnblockedByClose(ctx, resolvedIDs[0])
 			if err == nil && len(unblocked) > 0 {
 				if jsonOutput {
-					outputJSON(map[string]interface{}{
+					out := map[string]interface{}{
 						"closed":    closedIssues,
 						"unblocked": unblocked,
-					})
+					}
+					if len(autoClosedEpics) > 0 {
+						out["auto_closed"] = autoClosedEpics
+					}
+					outputJSON(out)
 					return
 				}
 				fmt.Printf("\nNewly unblocked:\n")
@@ -269,10 +281,14 @@ create, update, show, or close operation).`,
 			} else if result != nil {
 				if jsonOutput {
 					// Include continue result in JSON output
-					outputJSON(map[string]interface{}{
+					out := map[string]interface{}{
 						"closed":   closedIssues,
 						"continue": result,
-					})
+					}
+					if len(autoClosedEpics) > 0 {
+						out["auto_closed"] = autoClosedEpics
+					}
+					outputJSON(out)
 					return
 				}
 				PrintContinueResult(result)
@@ -309,11 +325,19 @@ create, update, show, or close operation).`,
 		}
 
 		if jsonOutput && len(closedIssues) > 0 {
-			if claimedNextIssue != nil {
-				outputJSON(map[string]interface{}{
-					"closed":  closedIssues,
-					"claimed": claimedNextIssue,
-				})
+			if claimedNextIssue != nil || len(autoClosedEpics) > 0 {
+				// Auto-closed epics switch the bare array to an object so
+				// scripts can see which parents were closed as a side effect.
+				out := map[string]interface{}{
+					"closed": closedIssues,
+				}
+				if claimedNextIssue != nil {
+					out["claimed"] = claimedNextIssue
+				}
+				if len(autoClosedEpics) > 0 {
+					out["auto_closed"] = autoClosedEpics
+				}
+				outputJSON(out)
 			} else {
 				outputJSON(closedIssues)
 			}
@@ -445,6 +469,25 @@ func autoCloseCompletedMolecule(ctx context.Context, s storage.DoltStorage, clos
 	}
 }
 
+// autoCloseCompletedEpics runs storage.PropagateClose when close.auto-close-epics
+// is enabled, reporting and returning the epics it closed. Best effort: errors
+// are warnings.
+func autoCloseCompletedEpics(ctx context.Context, s storage.DoltStorage, closedIssueID, actorName, session string) []string {
+	if !config.GetBool("close.auto-close-epics") {
+		return nil
+	}
+	closed, err := storage.PropagateClose(ctx, s, closedIssueID, actorName, session)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not auto-close parent epics of %s: %v\n", closedIssueID, err)
+	}
+	if !jsonOutput {
+		for _, id := range closed {
+			fmt.Printf("%s Auto-closed completed epic %s\n", ui.RenderPass("✓"), id)
+		}
+	}
+	return closed
+}
+
 // countEpicOpenChildren returns the number of open (non-closed) children for an epic.
 // Uses GetDependentsWithMetadata to find parent-child relationships.
 func countEpicOpenChildren(ctx context.Context, epicID string) int {
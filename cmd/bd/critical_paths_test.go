@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -381,6 +382,146 @@ func TestAutoCloseCompletedMolecule(t *testing.T) {
 	})
 }
 
+// TestPropagateEpicClose tests closing parent epics once their last child closes.
+func TestPropagateEpicClose(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	testDB := filepath.Join(tmpDir, ".beads", "beads.db")
+	s := newTestStore(t, testDB)
+
+	create := func(t *testing.T, title string, issueType types.IssueType, parentID string) *types.Issue {
+		t.Helper()
+		issue := &types.Issue{
+			Title:     title,
+			Status:    types.StatusOpen,
+			Priority:  2,
+			IssueType: issueType,
+			CreatedAt: time.Now(),
+		}
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("Failed to create %s: %v", title, err)
+		}
+		if parentID != "" {
+			if err := s.AddDependency(ctx, &types.Dependency{
+				IssueID:     issue.ID,
+				DependsOnID: parentID,
+				Type:        types.DepParentChild,
+			}, "test"); err != nil {
+				t.Fatalf("Failed to add parent-child dep: %v", err)
+			}
+		}
+		return issue
+	}
+	closeIssue := func(t *testing.T, id string) {
+		t.Helper()
+		if err := s.CloseIssue(ctx, id, "done", "test-actor", "test-session"); err != nil {
+			t.Fatalf("Failed to close %s: %v", id, err)
+		}
+	}
+	assertStatus := func(t *testing.T, id string, want types.Status) {
+		t.Helper()
+		issue, err := s.GetIssue(ctx, id)
+		if err != nil {
+			t.Fatalf("Failed to get %s: %v", id, err)
+		}
+		if issue.Status != want {
+			t.Errorf("%s status = %q, want %q", id, issue.Status, want)
+		}
+	}
+
+	t.Run("SingleLevel", func(t *testing.T) {
+		epic := create(t, "Single-level epic", types.TypeEpic, "")
+		task1 := create(t, "Task 1", types.TypeTask, epic.ID)
+		task2 := create(t, "Task 2", types.TypeTask, epic.ID)
+
+		closeIssue(t, task1.ID)
+		closed, err := storage.PropagateClose(ctx, s, task1.ID, "test-actor", "test-session")
+		if err != nil {
+			t.Fatalf("PropagateClose: %v", err)
+		}
+		if len(closed) != 0 {
+			t.Errorf("closed = %v, want none while %s is open", closed, task2.ID)
+		}
+		assertStatus(t, epic.ID, types.StatusOpen)
+
+		closeIssue(t, task2.ID)
+		closed, err = storage.PropagateClose(ctx, s, task2.ID, "test-actor", "test-session")
+		if err != nil {
+			t.Fatalf("PropagateClose: %v", err)
+		}
+		if len(closed) != 1 || closed[0] != epic.ID {
+			t.Errorf("closed = %v, want [%s]", closed, epic.ID)
+		}
+		assertStatus(t, epic.ID, types.StatusClosed)
+
+		// Re-running once everything is closed is a no-op.
+		closed, err = storage.PropagateClose(ctx, s, task2.ID, "test-actor", "test-session")
+		if err != nil {
+			t.Fatalf("PropagateClose (rerun): %v", err)
+		}
+		if len(closed) != 0 {
+			t.Errorf("rerun closed = %v, want none", closed)
+		}
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		outer := create(t, "Outer epic", types.TypeEpic, "")
+		inner := create(t, "Inner epic", types.TypeEpic, outer.ID)
+		task := create(t, "Leaf task", types.TypeTask, inner.ID)
+
+		closeIssue(t, task.ID)
+		closed, err := storage.PropagateClose(ctx, s, task.ID, "test-actor", "test-session")
+		if err != nil {
+			t.Fatalf("PropagateClose: %v", err)
+		}
+		if len(closed) != 2 || closed[0] != inner.ID || closed[1] != outer.ID {
+			t.Errorf("closed = %v, want [%s %s]", closed, inner.ID, outer.ID)
+		}
+		assertStatus(t, inner.ID, types.StatusClosed)
+		assertStatus(t, outer.ID, types.StatusClosed)
+	})
+
+	t.Run("StopsAtNonEpicParent", func(t *testing.T) {
+		feature := create(t, "Feature parent", types.TypeFeature, "")
+		task := create(t, "Feature task", types.TypeTask, feature.ID)
+
+		closeIssue(t, task.ID)
+		closed, err := storage.PropagateClose(ctx, s, task.ID, "test-actor", "test-session")
+		if err != nil {
+			t.Fatalf("PropagateClose: %v", err)
+		}
+		if len(closed) != 0 {
+			t.Errorf("closed = %v, want none for non-epic parent", closed)
+		}
+		assertStatus(t, feature.ID, types.StatusOpen)
+	})
+
+	t.Run("MultipleParents", func(t *testing.T) {
+		epicA := create(t, "Epic A", types.TypeEpic, "")
+		epicB := create(t, "Epic B", types.TypeEpic, "")
+		task := create(t, "Shared task", types.TypeTask, epicA.ID)
+		if err := s.AddDependency(ctx, &types.Dependency{
+			IssueID:     task.ID,
+			DependsOnID: epicB.ID,
+			Type:        types.DepParentChild,
+		}, "test"); err != nil {
+			t.Fatalf("Failed to add second parent-child dep: %v", err)
+		}
+
+		closeIssue(t, task.ID)
+		closed, err := storage.PropagateClose(ctx, s, task.ID, "test-actor", "test-session")
+		if err != nil {
+			t.Fatalf("PropagateClose: %v", err)
+		}
+		if len(closed) != 2 {
+			t.Errorf("closed = %v, want both %s and %s", closed, epicA.ID, epicB.ID)
+		}
+		assertStatus(t, epicA.ID, types.StatusClosed)
+		assertStatus(t, epicB.ID, types.StatusClosed)
+	})
+}
+
 // TestFindStaleMolecules tests detection of complete-but-unclosed molecules.
 func TestFindStaleMolecules(t *testing.T) {
 	t.Parallel()
@@ -540,8 +540,9 @@ func (s *configStore) GetIssuesByIDs(_ context.Context, _ []string) ([]*types.Is
 func (s *configStore) UpdateIssue(_ context.Context, _ string, _ map[string]interface{}, _ string) error {
 	return nil
 }
-func (s *configStore) CloseIssue(_ context.Context, _, _, _, _ string) error { return nil }
-func (s *configStore) DeleteIssue(_ context.Context, _ string) error         { return nil }
+func (s *configStore) CloseIssue(_ context.Context, _, _, _, _ string) error           { return nil }
+func (s *configStore) CloseIssues(_ context.Context, _ []string, _, _, _ string) error { return nil }
+func (s *configStore) DeleteIssue(_ context.Context, _ string) error                   { return nil }
 func (s *configStore) SearchIssues(_ context.Context, _ string, _ types.IssueFilter) ([]*types.Issue, error) {
 	return nil, nil
 }
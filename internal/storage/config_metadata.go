@@ -10,6 +10,8 @@ import (
 type ConfigMetadataStore interface {
 	GetMetadata(ctx context.Context, key string) (string, error)
 	SetMetadata(ctx context.Context, key, value string) error
+	GetAllMetadata(ctx context.Context) (map[string]string, error)
+	DeleteMetadata(ctx context.Context, key string) error
 	DeleteConfig(ctx context.Context, key string) error
 	GetCustomStatuses(ctx context.Context) ([]string, error)
 	GetCustomTypes(ctx context.Context) ([]string, error)
@@ -86,6 +86,24 @@ func (s *DoltStore) GetMetadata(ctx context.Context, key string) (string, error)
 	return value, err
 }
 
+// GetAllMetadata retrieves all metadata values
+func (s *DoltStore) GetAllMetadata(ctx context.Context) (map[string]string, error) {
+	var result map[string]string
+	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = issueops.GetAllMetadataInTx(ctx, tx)
+		return err
+	})
+	return result, err
+}
+
+// DeleteMetadata removes a metadata value
+func (s *DoltStore) DeleteMetadata(ctx context.Context, key string) error {
+	return s.withWriteTx(ctx, func(tx *sql.Tx) error {
+		return issueops.DeleteMetadataInTx(ctx, tx, key)
+	})
+}
+
 // GetCustomStatuses returns custom status values from config.
 // If the database doesn't have custom statuses configured, falls back to config.yaml.
 // Returns an empty slice if no custom statuses are configured.
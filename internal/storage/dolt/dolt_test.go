@@ -15,6 +15,7 @@ import (
 
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/doltutil"
+	"github.com/steveyegge/beads/internal/storage/issueops"
 	"github.com/steveyegge/beads/internal/testutil"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -620,6 +621,79 @@ func TestDoltStoreIssueClose(t *testing.T) {
 	if retrieved.ClosedAt == nil {
 		t.Error("expected closed_at to be set")
 	}
+
+	// Closing a missing issue reports ErrNotFound, as the embedded store does
+	if err := store.CloseIssue(ctx, "test-missing-close", "completed", "tester", ""); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("expected ErrNotFound closing a missing issue, got: %v", err)
+	}
+}
+
+func TestDoltStoreCloseIssues(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	var ids []string
+	for _, title := range []string{"Open A", "Already closed", "Open B", "Open C"} {
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create %q: %v", title, err)
+		}
+		ids = append(ids, issue.ID)
+	}
+	openA, alreadyClosed, openB, openC := ids[0], ids[1], ids[2], ids[3]
+	if err := store.CloseIssue(ctx, alreadyClosed, "earlier", "tester", ""); err != nil {
+		t.Fatalf("failed to pre-close issue: %v", err)
+	}
+
+	// A missing ID rolls back the whole batch
+	err := store.CloseIssues(ctx, []string{openC, "missing-1"}, "batch", "tester", "session123")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for missing ID, got %v", err)
+	}
+	var missingErr *storage.MissingIssuesError
+	if !errors.As(err, &missingErr) || len(missingErr.IDs) != 1 || missingErr.IDs[0] != "missing-1" {
+		t.Errorf("expected MissingIssuesError for [missing-1], got %v", err)
+	}
+	retrieved, err := store.GetIssue(ctx, openC)
+	if err != nil {
+		t.Fatalf("failed to get issue: %v", err)
+	}
+	if retrieved.Status != types.StatusOpen {
+		t.Errorf("expected %s to stay open after rollback, got %s", openC, retrieved.Status)
+	}
+
+	// Mixed batch of open and already-closed issues
+	if err := store.CloseIssues(ctx, []string{openA, alreadyClosed, openB}, "batch", "tester", "session123"); err != nil {
+		t.Fatalf("failed to close issues: %v", err)
+	}
+	for _, id := range []string{openA, alreadyClosed, openB} {
+		retrieved, err := store.GetIssue(ctx, id)
+		if err != nil {
+			t.Fatalf("failed to get %s: %v", id, err)
+		}
+		if retrieved.Status != types.StatusClosed {
+			t.Errorf("expected %s closed, got %s", id, retrieved.Status)
+		}
+	}
+	retrieved, err = store.GetIssue(ctx, alreadyClosed)
+	if err != nil {
+		t.Fatalf("failed to get issue: %v", err)
+	}
+	if retrieved.CloseReason != "earlier" {
+		t.Errorf("expected already-closed issue to keep reason %q, got %q", "earlier", retrieved.CloseReason)
+	}
+
+	// The commit message counts only the issues actually closed
+	var message string
+	if err := store.db.QueryRowContext(ctx, "SELECT message FROM dolt_log LIMIT 1").Scan(&message); err != nil {
+		t.Fatalf("failed to read dolt_log: %v", err)
+	}
+	if message != "bd: close 2 issue(s)" {
+		t.Errorf("expected commit message %q, got %q", "bd: close 2 issue(s)", message)
+	}
 }
 
 // TestClosePromotedWisp verifies that bd close works for wisps that were
@@ -1083,6 +1157,11 @@ func TestDoltStoreDeleteIssue(t *testing.T) {
 	if !errors.Is(err, storage.ErrNotFound) {
 		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
 	}
+
+	// Deleting it again reports ErrNotFound, as the embedded store does
+	if err := store.DeleteIssue(ctx, issue.ID); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("expected ErrNotFound deleting a missing issue, got: %v", err)
+	}
 }
 
 // TestDeleteIssuesBatchPerformance verifies that batch deletion works correctly
@@ -1612,9 +1691,9 @@ func TestValidateRef(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateRef(tt.ref)
+			err := issueops.ValidateRef(tt.ref)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("validateRef(%q) error = %v, wantErr %v", tt.ref, err, tt.wantErr)
+				t.Errorf("ValidateRef(%q) error = %v, wantErr %v", tt.ref, err, tt.wantErr)
 			}
 		})
 	}
@@ -1827,6 +1906,59 @@ func TestDoltStoreGetReadyWork(t *testing.T) {
 	}
 }
 
+func TestDoltStoreGetReadyWorkFiltersWisps(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping slow Dolt integration test in short mode")
+	}
+
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	for _, issue := range []*types.Issue{
+		{ID: "test-wisp-human", Title: "Needs a human", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, Ephemeral: true},
+		{ID: "test-wisp-agent", Title: "Agent work", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, Ephemeral: true},
+		{ID: "test-wisp-low", Title: "Low priority", Status: types.StatusOpen, Priority: 4, IssueType: types.TypeTask, Ephemeral: true},
+		{ID: "test-wisp-bug", Title: "Bug", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeBug, Ephemeral: true},
+	} {
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create %s: %v", issue.ID, err)
+		}
+	}
+	if err := store.AddLabel(ctx, "test-wisp-human", "needs-human", "tester"); err != nil {
+		t.Fatalf("failed to add label: %v", err)
+	}
+
+	maxPriority := 2
+	ready, err := store.GetReadyWork(ctx, types.WorkFilter{
+		IncludeEphemeral: true,
+		ExcludeLabels:    []string{"needs-human"},
+		PriorityMax:      &maxPriority,
+		Types:            []string{"task"},
+	})
+	if err != nil {
+		t.Fatalf("failed to get ready work: %v", err)
+	}
+	found := make(map[string]bool)
+	for _, issue := range ready {
+		found[issue.ID] = true
+	}
+	if !found["test-wisp-agent"] {
+		t.Error("expected unlabelled task wisp in ready work")
+	}
+	if found["test-wisp-human"] {
+		t.Error("expected wisp labelled needs-human to be excluded")
+	}
+	if found["test-wisp-low"] {
+		t.Error("expected wisp below the priority floor to be excluded")
+	}
+	if found["test-wisp-bug"] {
+		t.Error("expected wisp outside the type allow-list to be excluded")
+	}
+}
+
 func TestDoltStoreGetReadyWorkWaitsForChildrenOfSpawner(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping slow Dolt integration test in short mode")
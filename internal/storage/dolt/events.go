@@ -29,33 +29,19 @@ func (s *DoltStore) AddComment(ctx context.Context, issueID, actor, comment stri
 	return nil
 }
 
-// GetEvents retrieves events for an issue
+// GetEvents retrieves events for an issue, newest first.
+// Delegates to issueops.GetEventsInTx for shared query logic.
 func (s *DoltStore) GetEvents(ctx context.Context, issueID string, limit int) ([]*types.Event, error) {
-	table := "events"
-	if s.isActiveWisp(ctx, issueID) {
-		table = "wisp_events"
-	}
-
-	//nolint:gosec // G201: table is hardcoded
-	query := fmt.Sprintf(`
-		SELECT id, issue_id, event_type, actor, old_value, new_value, comment, created_at
-		FROM %s
-		WHERE issue_id = ?
-		ORDER BY created_at DESC
-	`, table)
-	args := []interface{}{issueID}
-
-	if limit > 0 {
-		query += fmt.Sprintf(" LIMIT %d", limit)
-	}
-
-	rows, err := s.queryContext(ctx, query, args...)
+	var events []*types.Event
+	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		events, err = issueops.GetEventsInTx(ctx, tx, issueID, limit)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get events: %w", err)
 	}
-	defer rows.Close()
-
-	return scanEvents(rows)
+	return events, nil
 }
 
 // GetAllEventsSince returns all events created after the given time, ordered by creation time.
@@ -93,3 +93,51 @@ func TestGetAllEventsSince_EmptyStore(t *testing.T) {
 		t.Errorf("expected 0 events from empty store, got %d", len(events))
 	}
 }
+
+// TestUpdateWispRecordsAssigneeChange verifies that reassigning a wisp records
+// an assignee_changed event in wisp_events, as it does for permanent issues.
+func TestUpdateWispRecordsAssigneeChange(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	wisp := &types.Issue{
+		ID:        "test-ev-reassign",
+		Title:     "Wisp Issue",
+		Status:    types.StatusOpen,
+		Priority:  2,
+		IssueType: types.TypeTask,
+		Ephemeral: true,
+		Assignee:  "alice",
+	}
+	if err := store.CreateIssue(ctx, wisp, "tester"); err != nil {
+		t.Fatalf("failed to create wisp issue: %v", err)
+	}
+
+	if err := store.UpdateIssue(ctx, wisp.ID, map[string]interface{}{"assignee": "bob"}, "tester"); err != nil {
+		t.Fatalf("failed to reassign wisp: %v", err)
+	}
+
+	events, err := store.GetEvents(ctx, wisp.ID, 0)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	var found bool
+	for _, e := range events {
+		if e.EventType != types.EventAssigneeChanged {
+			continue
+		}
+		found = true
+		if e.OldValue == nil || *e.OldValue != "alice" || e.NewValue == nil || *e.NewValue != "bob" {
+			t.Errorf("assignee_changed values = %v -> %v, want alice -> bob", e.OldValue, e.NewValue)
+		}
+		if e.Actor != "tester" {
+			t.Errorf("assignee_changed actor = %q, want %q", e.Actor, "tester")
+		}
+	}
+	if !found {
+		t.Error("expected assignee_changed event in wisp_events, not found")
+	}
+}
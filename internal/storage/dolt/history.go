@@ -8,33 +8,16 @@ import (
 	"time"
 
 	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
 	"github.com/steveyegge/beads/internal/types"
 )
 
-// validRefPattern matches valid Dolt commit hashes (32 hex chars) or branch names.
-// Allows dots and slashes for branch names like "release/v2.0" or "feature/auth.flow".
-var validRefPattern = regexp.MustCompile(`^[a-zA-Z0-9_./-]+$`)
-
 // validTablePattern matches valid table names
 var validTablePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
 
 // validDatabasePattern matches valid MySQL database names (alphanumeric, underscore, hyphen)
 var validDatabasePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_\-]*$`)
 
-// validateRef checks if a ref is safe to use in queries
-func validateRef(ref string) error {
-	if ref == "" {
-		return fmt.Errorf("ref cannot be empty")
-	}
-	if len(ref) > 128 {
-		return fmt.Errorf("ref too long")
-	}
-	if !validRefPattern.MatchString(ref) {
-		return fmt.Errorf("invalid ref format: %s", ref)
-	}
-	return nil
-}
-
 // ValidateDatabaseName checks if a database name is safe to use in queries.
 // Prevents SQL injection via backtick escaping in CREATE DATABASE statements.
 func ValidateDatabaseName(name string) error {
@@ -159,7 +142,8 @@ func (s *DoltStore) getIssueHistory(ctx context.Context, issueID string) ([]*iss
 // getIssueAsOf returns an issue as it existed at a specific commit or time
 func (s *DoltStore) getIssueAsOf(ctx context.Context, issueID string, ref string) (*types.Issue, error) {
 	// Validate ref to prevent SQL injection
-	if err := validateRef(ref); err != nil {
+	asOf, err := issueops.AsOfExpr(ref)
+	if err != nil {
 		return nil, fmt.Errorf("invalid ref: %w", err)
 	}
 
@@ -169,15 +153,15 @@ func (s *DoltStore) getIssueAsOf(ctx context.Context, issueID string, ref string
 	var assignee, owner, contentHash sql.NullString
 	var estimatedMinutes sql.NullInt64
 
-	// nolint:gosec // G201: ref is validated by validateRef() above - AS OF requires literal
+	// nolint:gosec // G201: asOf is built by issueops.AsOfExpr() above - AS OF requires literal
 	query := fmt.Sprintf(`
 		SELECT id, content_hash, title, description, status, priority, issue_type, assignee, estimated_minutes,
 		       created_at, created_by, owner, updated_at, closed_at
-		FROM issues AS OF '%s'
+		FROM issues AS OF %s
 		WHERE id = ?
-	`, ref)
+	`, asOf)
 
-	err := s.db.QueryRowContext(ctx, query, issueID).Scan(
+	err = s.db.QueryRowContext(ctx, query, issueID).Scan(
 		&issue.ID, &contentHash, &issue.Title, &issue.Description, &issue.Status, &issue.Priority, &issue.IssueType, &assignee, &estimatedMinutes,
 		&createdAtStr, &issue.CreatedBy, &owner, &updatedAtStr, &closedAt,
 	)
@@ -163,75 +163,8 @@ func (s *DoltStore) UpdateIssue(ctx context.Context, id string, updates map[stri
 	}
 	defer func() { _ = tx.Rollback() }() // No-op after successful commit
 
-	// Read inside transaction to avoid TOCTOU race
-	oldIssue, err := scanIssueTxFromTable(ctx, tx, "issues", id)
-	if err != nil {
-		return fmt.Errorf("failed to get issue for update: %w", err)
-	}
-
-	// Build update query
-	setClauses := []string{"updated_at = ?"}
-	args := []interface{}{time.Now().UTC()}
-
-	for key, value := range updates {
-		if !isAllowedUpdateField(key) {
-			return fmt.Errorf("invalid field for update: %s", key)
-		}
-
-		columnName := key
-		if key == "wisp" {
-			columnName = "ephemeral"
-		}
-		setClauses = append(setClauses, fmt.Sprintf("`%s` = ?", columnName))
-
-		// Handle JSON serialization for array fields stored as TEXT
-		if key == "waiters" {
-			waitersJSON, _ := json.Marshal(value)
-			args = append(args, string(waitersJSON))
-		} else if key == "metadata" {
-			// GH#1417: Normalize metadata to string, accepting string/[]byte/json.RawMessage
-			// Schema validation already ran in the pre-routing block above.
-			metadataStr, err := storage.NormalizeMetadataValue(value)
-			if err != nil {
-				return fmt.Errorf("invalid metadata: %w", err)
-			}
-			args = append(args, metadataStr)
-		} else {
-			args = append(args, value)
-		}
-	}
-
-	// Auto-clear pinned column when status transitions away from "pinned".
-	// The legacy pinned=1 column can cause beads to be invisible to bd list
-	// when combined with non-pinned statuses (e.g., hooked). Clear it on
-	// any status transition away from pinned to prevent stale flag issues.
-	if newStatus, ok := updates["status"]; ok {
-		if oldIssue.Pinned && newStatus != "pinned" {
-			if _, alreadySet := updates["pinned"]; !alreadySet {
-				setClauses = append(setClauses, "`pinned` = ?")
-				args = append(args, false)
-			}
-		}
-	}
-
-	// Auto-manage closed_at
-	setClauses, args = manageClosedAt(oldIssue, updates, setClauses, args)
-
-	args = append(args, id)
-
-	// nolint:gosec // G201: setClauses contains only column names (e.g. "status = ?"), actual values passed via args
-	query := fmt.Sprintf("UPDATE issues SET %s WHERE id = ?", strings.Join(setClauses, ", "))
-	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
-		return fmt.Errorf("failed to update issue: %w", err)
-	}
-
-	// Record event
-	oldData, _ := json.Marshal(oldIssue)
-	newData, _ := json.Marshal(updates)
-	eventType := determineEventType(oldIssue, updates)
-
-	if err := recordEvent(ctx, tx, id, eventType, actor, string(oldData), string(newData)); err != nil {
-		return fmt.Errorf("failed to record event: %w", err)
+	if err := issueops.UpdateIssueInTx(ctx, tx, id, updates, actor); err != nil {
+		return err
 	}
 
 	// GH#2455: Stage only the tables we modified, then commit without -A.
@@ -340,32 +273,14 @@ func (s *DoltStore) CloseIssue(ctx context.Context, id string, reason string, ac
 		return s.closeWisp(ctx, id, reason, actor, session)
 	}
 
-	now := time.Now().UTC()
-
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback() }() // No-op after successful commit
 
-	result, err := tx.ExecContext(ctx, `
-		UPDATE issues SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?, closed_by_session = ?
-		WHERE id = ?
-	`, types.StatusClosed, now, now, reason, session, id)
-	if err != nil {
-		return fmt.Errorf("failed to close issue: %w", err)
-	}
-
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-	if rows == 0 {
-		return fmt.Errorf("issue not found: %s", id)
-	}
-
-	if err := recordEvent(ctx, tx, id, types.EventClosed, actor, "", reason); err != nil {
-		return fmt.Errorf("failed to record event: %w", err)
+	if err := issueops.CloseIssueInTx(ctx, tx, id, reason, actor, session); err != nil {
+		return err
 	}
 
 	// GH#2455: Stage only the tables we modified, then commit without -A.
@@ -386,11 +301,12 @@ func (s *DoltStore) CloseIssue(ctx context.Context, id string, reason string, ac
 	return nil
 }
 
-// DeleteIssue permanently removes an issue
-func (s *DoltStore) DeleteIssue(ctx context.Context, id string) error {
-	// Route ephemeral IDs to wisps table (falls through for promoted wisps)
-	if s.isActiveWisp(ctx, id) {
-		return s.deleteWisp(ctx, id)
+// CloseIssues closes several issues in one transaction and one Dolt commit.
+// Already-closed issues are skipped; if any ID does not exist nothing is
+// closed and a *storage.MissingIssuesError is returned.
+func (s *DoltStore) CloseIssues(ctx context.Context, ids []string, reason string, actor string, session string) error {
+	if len(ids) == 0 {
+		return nil
 	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -399,35 +315,40 @@ func (s *DoltStore) DeleteIssue(ctx context.Context, id string) error {
 	}
 	defer func() { _ = tx.Rollback() }() // No-op after successful commit
 
-	// Delete related data (foreign keys will cascade, but be explicit)
-	tables := []string{"dependencies", "events", "comments", "labels"}
-	for _, table := range tables {
-		// Validate table name to prevent SQL injection (tables are hardcoded above,
-		// but validate defensively in case the list is ever modified)
-		if err := validateTableName(table); err != nil {
-			return fmt.Errorf("invalid table name %q: %w", table, err)
-		}
-		if table == "dependencies" {
-			_, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE issue_id = ? OR depends_on_id = ?", table), id, id) //nolint:gosec // G201: table validated by validateTableName above
-		} else {
-			_, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE issue_id = ?", table), id) //nolint:gosec // G201: table validated by validateTableName above
-		}
-		if err != nil {
-			return fmt.Errorf("failed to delete from %s: %w", table, err)
-		}
+	closed, err := issueops.CloseIssuesInTx(ctx, tx, ids, reason, actor, session)
+	if err != nil {
+		return err
 	}
 
-	result, err := tx.ExecContext(ctx, "DELETE FROM issues WHERE id = ?", id)
-	if err != nil {
-		return fmt.Errorf("failed to delete issue: %w", err)
+	// GH#2455: Stage only the tables we modified, then commit without -A.
+	for _, table := range []string{"issues", "events"} {
+		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
+	}
+	commitMsg := fmt.Sprintf("bd: close %d issue(s)", len(closed))
+	if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
+		commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+		return fmt.Errorf("dolt commit: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return wrapTransactionError("commit close issues", err)
 	}
+	s.invalidateBlockedIDsCache()
+	return nil
+}
 
-	rows, err := result.RowsAffected()
+// DeleteIssue permanently removes an issue
+func (s *DoltStore) DeleteIssue(ctx context.Context, id string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	if rows == 0 {
-		return fmt.Errorf("issue not found: %s", id)
+	defer func() { _ = tx.Rollback() }() // No-op after successful commit
+
+	// Routes to the wisp tables itself; deleting either kind can also remove
+	// edges from the versioned dependencies table, so always stage and commit.
+	if err := issueops.DeleteIssueInTx(ctx, tx, id); err != nil {
+		return err
 	}
 
 	// GH#2455: Stage only the tables we modified, then commit without -A.
@@ -441,7 +362,7 @@ func (s *DoltStore) DeleteIssue(ctx context.Context, id string) error {
 	}
 
 	if err := tx.Commit(); err != nil {
-		return err
+		return wrapTransactionError("commit delete issue", err)
 	}
 	s.invalidateBlockedIDsCache()
 	return nil
@@ -968,72 +889,6 @@ func generateHashID(prefix, title, description, creator string, timestamp time.T
 	return idgen.GenerateHashID(prefix, title, description, creator, timestamp, length, nonce)
 }
 
-func isAllowedUpdateField(key string) bool {
-	allowed := map[string]bool{
-		"status": true, "priority": true, "title": true, "assignee": true,
-		"description": true, "design": true, "acceptance_criteria": true, "notes": true,
-		"issue_type": true, "estimated_minutes": true, "external_ref": true, "spec_id": true,
-		"closed_at": true, "close_reason": true, "closed_by_session": true,
-		"source_repo": true,
-		"sender":      true, "wisp": true, "wisp_type": true, "no_history": true, "pinned": true,
-		"hook_bead": true, "role_bead": true, "agent_state": true, "last_activity": true,
-		"role_type": true, "rig": true, "mol_type": true, "holder": true,
-		"event_category": true, "event_actor": true, "event_target": true, "event_payload": true,
-		"due_at": true, "defer_until": true, "await_id": true, "waiters": true,
-		"metadata": true,
-	}
-	return allowed[key]
-}
-
-func manageClosedAt(oldIssue *types.Issue, updates map[string]interface{}, setClauses []string, args []interface{}) ([]string, []interface{}) {
-	statusVal, hasStatus := updates["status"]
-	_, hasExplicitClosedAt := updates["closed_at"]
-	if hasExplicitClosedAt || !hasStatus {
-		return setClauses, args
-	}
-
-	var newStatus string
-	switch v := statusVal.(type) {
-	case string:
-		newStatus = v
-	case types.Status:
-		newStatus = string(v)
-	default:
-		return setClauses, args
-	}
-
-	if newStatus == string(types.StatusClosed) {
-		now := time.Now().UTC()
-		setClauses = append(setClauses, "closed_at = ?")
-		args = append(args, now)
-	} else if oldIssue.Status == types.StatusClosed {
-		setClauses = append(setClauses, "closed_at = ?", "close_reason = ?")
-		args = append(args, nil, "")
-	}
-
-	return setClauses, args
-}
-
-func determineEventType(oldIssue *types.Issue, updates map[string]interface{}) types.EventType {
-	statusVal, hasStatus := updates["status"]
-	if !hasStatus {
-		return types.EventUpdated
-	}
-
-	newStatus, ok := statusVal.(string)
-	if !ok {
-		return types.EventUpdated
-	}
-
-	if newStatus == string(types.StatusClosed) {
-		return types.EventClosed
-	}
-	if oldIssue.Status == types.StatusClosed {
-		return types.EventReopened
-	}
-	return types.EventStatusChanged
-}
-
 // Aliases for shared nullable helpers from issueops.
 var (
 	nullString    = issueops.NullString
@@ -1042,6 +897,9 @@ var (
 	nullIntVal    = issueops.NullIntVal
 )
 
+// Alias for the shared update field allow-list from issueops.
+var isAllowedUpdateField = issueops.IsAllowedUpdateField
+
 // Aliases for shared helpers from issueops.
 var (
 	jsonMetadata          = issueops.JSONMetadata
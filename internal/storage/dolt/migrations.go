@@ -30,6 +30,7 @@ var migrationsList = []Migration{
 	{"cleanup_autopush_metadata", migrations.MigrateCleanupAutopushMetadata},
 	{"uuid_primary_keys", migrations.MigrateUUIDPrimaryKeys},
 	{"add_no_history_column", migrations.MigrateAddNoHistoryColumn},
+	{"status_priority_index", migrations.MigrateStatusPriorityIndex},
 }
 
 // RunMigrations executes all registered Dolt migrations in order.
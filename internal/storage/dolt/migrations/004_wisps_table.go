@@ -110,6 +110,7 @@ const wispsTableSchema = `CREATE TABLE IF NOT EXISTS wisps (
     defer_until DATETIME,
     INDEX idx_wisps_status (status),
     INDEX idx_wisps_priority (priority),
+    INDEX idx_wisps_status_priority (status, priority),
     INDEX idx_wisps_issue_type (issue_type),
     INDEX idx_wisps_assignee (assignee),
     INDEX idx_wisps_created_at (created_at),
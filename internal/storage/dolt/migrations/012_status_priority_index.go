@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateStatusPriorityIndex adds composite (status, priority) indices on the
+// issues and wisps tables. Ready-work and list queries filter by status and
+// order or range-filter by priority; the single-column indices force the
+// planner to pick one and filter the other row by row.
+//
+// Idempotent: checks for existing indices before creating.
+func MigrateStatusPriorityIndex(db *sql.DB) error {
+	indices := []struct {
+		table string
+		name  string
+	}{
+		{table: "issues", name: "idx_issues_status_priority"},
+		{table: "wisps", name: "idx_wisps_status_priority"},
+	}
+
+	for _, idx := range indices {
+		exists, err := tableExists(db, idx.table)
+		if err != nil {
+			return fmt.Errorf("checking %s table: %w", idx.table, err)
+		}
+		if !exists || indexExists(db, idx.table, idx.name) {
+			continue
+		}
+		//nolint:gosec // G201: table and index names are from hardcoded list
+		if _, err := db.Exec(fmt.Sprintf("CREATE INDEX %s ON %s (status, priority)", idx.name, idx.table)); err != nil {
+			return fmt.Errorf("creating index %s: %w", idx.name, err)
+		}
+	}
+	return nil
+}
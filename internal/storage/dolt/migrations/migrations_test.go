@@ -282,6 +282,59 @@ func TestMigrateIssueCounterTable(t *testing.T) {
 	}
 }
 
+func TestMigrateStatusPriorityIndex(t *testing.T) {
+	db := openTestDoltBranch(t)
+
+	// The base test schema predates priority; add it so the index can be built.
+	if _, err := db.Exec("ALTER TABLE issues ADD COLUMN priority INT NOT NULL DEFAULT 2"); err != nil {
+		t.Fatalf("failed to add priority column: %v", err)
+	}
+	if indexExists(db, "issues", "idx_issues_status_priority") {
+		t.Fatal("idx_issues_status_priority should not exist yet")
+	}
+
+	// Run migration (wisps table is absent and must be skipped)
+	if err := MigrateStatusPriorityIndex(db); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	if !indexExists(db, "issues", "idx_issues_status_priority") {
+		t.Fatal("idx_issues_status_priority should exist after migration")
+	}
+
+	// Run migration again (idempotent)
+	if err := MigrateStatusPriorityIndex(db); err != nil {
+		t.Fatalf("re-running migration should be idempotent: %v", err)
+	}
+
+	// Verify mixed priorities within one status come back in priority order
+	_, err := db.Exec(`INSERT INTO issues (id, title, status, priority) VALUES
+		('sp-3', 'p3', 'open', 3), ('sp-0', 'p0', 'open', 0), ('sp-c', 'closed', 'closed', 0),
+		('sp-1', 'p1', 'open', 1), ('sp-2', 'p2', 'open', 2)`)
+	if err != nil {
+		t.Fatalf("failed to insert issues: %v", err)
+	}
+	rows, err := db.Query("SELECT id FROM issues WHERE status = 'open' ORDER BY priority")
+	if err != nil {
+		t.Fatalf("failed to query issues: %v", err)
+	}
+	defer rows.Close()
+	var got []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			t.Fatalf("failed to scan id: %v", err)
+		}
+		got = append(got, id)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("rows error: %v", err)
+	}
+	want := []string{"sp-0", "sp-1", "sp-2", "sp-3"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected order %v, got %v", want, got)
+	}
+}
+
 func TestColumnExistsNoTable(t *testing.T) {
 	db := openTestDoltBranch(t)
 
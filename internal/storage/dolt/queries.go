@@ -32,6 +32,10 @@ func (s *DoltStore) SearchIssues(ctx context.Context, query string, filter types
 // The molecule subgraph analysis (analyzeMoleculeParallel) uses equivalent
 // logic scoped to an in-memory subgraph rather than the full database.
 func (s *DoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) ([]*types.Issue, error) {
+	if err := storage.CheckReadyWorkFilter(filter); err != nil {
+		return nil, err
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -58,10 +62,21 @@ func (s *DoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) (
 		whereClauses = append(whereClauses, "priority = ?")
 		args = append(args, *filter.Priority)
 	}
+	if filter.PriorityMax != nil {
+		whereClauses = append(whereClauses, "priority <= ?")
+		args = append(args, *filter.PriorityMax)
+	}
 	// Use subquery for type filter to prevent Dolt mergeJoinIter panic (see SearchIssues).
-	if filter.Type != "" {
-		whereClauses = append(whereClauses, "id IN (SELECT id FROM issues WHERE issue_type = ?)")
-		args = append(args, filter.Type)
+	if filter.Type != "" || len(filter.Types) > 0 {
+		if filter.Type != "" {
+			whereClauses = append(whereClauses, "id IN (SELECT id FROM issues WHERE issue_type = ?)")
+			args = append(args, filter.Type)
+		}
+		if len(filter.Types) > 0 {
+			placeholders, typeArgs := doltBuildSQLInClause(filter.Types)
+			whereClauses = append(whereClauses, fmt.Sprintf("id IN (SELECT id FROM issues WHERE issue_type IN (%s))", placeholders))
+			args = append(args, typeArgs...)
+		}
 	} else {
 		// Exclude workflow/identity types from ready work by default.
 		// These are internal items, not actionable work for agents to claim:
@@ -91,6 +106,14 @@ func (s *DoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) (
 	if !filter.IncludeDeferred {
 		whereClauses = append(whereClauses, "(defer_until IS NULL OR defer_until <= NOW())")
 	}
+	if filter.MolType != nil {
+		whereClauses = append(whereClauses, "mol_type = ?")
+		args = append(args, string(*filter.MolType))
+	}
+	if filter.WispType != nil {
+		whereClauses = append(whereClauses, "wisp_type = ?")
+		args = append(args, string(*filter.WispType))
+	}
 	// Exclude children of future-deferred parents (GH#1190)
 	// Pre-compute excluded IDs using separate single-table queries to avoid
 	// correlated cross-table JOIN subquery that triggers Dolt joinIter hangs.
@@ -115,6 +138,11 @@ func (s *DoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) (
 			args = append(args, label)
 		}
 	}
+	if len(filter.ExcludeLabels) > 0 {
+		placeholders, labelArgs := doltBuildSQLInClause(filter.ExcludeLabels)
+		whereClauses = append(whereClauses, fmt.Sprintf("id NOT IN (SELECT issue_id FROM labels WHERE label IN (%s))", placeholders))
+		args = append(args, labelArgs...)
+	}
 	// Parent filtering: filter to children of specified parent (GH#2009)
 	// Explicit parent-child dependency takes precedence over dotted-ID prefix.
 	if filter.ParentID != nil {
@@ -222,22 +250,70 @@ func (s *DoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) (
 	}
 
 	// When IncludeEphemeral is set, also query the wisps table for ready work.
+	// Column filters are pushed into the wisp query; filter.Matches then applies
+	// the full WorkFilter (type allow-list, label exclusions) on loaded labels.
 	if filter.IncludeEphemeral {
-		wispFilter := types.IssueFilter{Limit: filter.Limit}
+		wispFilter := types.IssueFilter{
+			Priority:    filter.Priority,
+			PriorityMax: filter.PriorityMax,
+			Labels:      filter.Labels,
+			LabelsAny:   filter.LabelsAny,
+			MolType:     filter.MolType,
+			WispType:    filter.WispType,
+		}
 		if filter.Status != "" {
 			s := filter.Status
 			wispFilter.Status = &s
 		}
+		// Only limit in SQL when no rows can be dropped after the query.
+		if len(filter.Types) == 0 && len(filter.ExcludeLabels) == 0 {
+			wispFilter.Limit = filter.Limit
+		}
 		wisps, wErr := s.searchWisps(ctx, "", wispFilter)
 		if wErr != nil && !isTableNotExistError(wErr) {
 			return nil, fmt.Errorf("search wisps (ready work): %w", wErr)
 		}
-		issues = append(issues, wisps...)
+		if err := s.loadLabelsInto(ctx, wisps); err != nil {
+			return nil, fmt.Errorf("failed to get labels for ready wisps: %w", err)
+		}
+		matched := 0
+		for _, wisp := range wisps {
+			if filter.Limit > 0 && matched == filter.Limit {
+				break
+			}
+			if filter.Matches(wisp) {
+				issues = append(issues, wisp)
+				matched++
+			}
+		}
 	}
 
 	return issues, nil
 }
 
+// loadLabelsInto sets Labels on each issue, routing wisps to wisp_labels.
+// GetIssuesByIDs and searchWisps do not load labels, but filter.Matches
+// needs them.
+func (s *DoltStore) loadLabelsInto(ctx context.Context, issues []*types.Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	return s.withReadTx(ctx, func(tx *sql.Tx) error {
+		labelMap, err := issueops.GetLabelsForIssuesInTx(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			issue.Labels = labelMap[issue.ID]
+		}
+		return nil
+	})
+}
+
 // GetBlockedIssues returns issues that are blocked by other issues.
 // Uses separate single-table queries with Go-level filtering to avoid
 // correlated EXISTS subqueries that trigger Dolt's joinIter panic
@@ -351,6 +427,11 @@ func (s *DoltStore) GetBlockedIssues(ctx context.Context, filter types.WorkFilte
 	if err != nil {
 		return nil, fmt.Errorf("failed to batch-fetch blocked issues: %w", err)
 	}
+	if len(filter.Labels) > 0 || len(filter.LabelsAny) > 0 || len(filter.ExcludeLabels) > 0 {
+		if err := s.loadLabelsInto(ctx, issues); err != nil {
+			return nil, fmt.Errorf("failed to get labels for blocked issues: %w", err)
+		}
+	}
 	issueMap := make(map[string]*types.Issue, len(issues))
 	for _, issue := range issues {
 		issueMap[issue.ID] = issue
@@ -383,7 +464,7 @@ func (s *DoltStore) GetBlockedIssues(ctx context.Context, filter types.WorkFilte
 		}
 
 		issue, ok := issueMap[id]
-		if !ok || issue == nil {
+		if !ok || issue == nil || !filter.Matches(issue) {
 			continue
 		}
 
@@ -407,145 +488,13 @@ func (s *DoltStore) GetBlockedIssues(ctx context.Context, filter types.WorkFilte
 
 // GetEpicsEligibleForClosure returns epics whose children are all closed
 func (s *DoltStore) GetEpicsEligibleForClosure(ctx context.Context) ([]*types.EpicStatus, error) {
-	// Use separate single-table queries to avoid Dolt's joinIter panic
-	// (join_iters.go:192) which triggers on multi-table JOINs.
-
-	// Step 1: Get open epic IDs (single-table scan)
-	epicRows, err := s.queryContext(ctx, `
-		SELECT id FROM issues
-		WHERE issue_type = 'epic'
-		  AND status != 'closed'
-	`)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get epics: %w", err)
-	}
-	var epicIDs []string
-	for epicRows.Next() {
-		var id string
-		if err := epicRows.Scan(&id); err != nil {
-			_ = epicRows.Close() // Best effort cleanup on error path
-			return nil, wrapScanError("get epics eligible for closure", err)
-		}
-		epicIDs = append(epicIDs, id)
-	}
-	_ = epicRows.Close() // Redundant close for safety (rows already iterated)
-
-	if len(epicIDs) == 0 {
-		return nil, nil
-	}
-
-	// Step 2: Get parent-child dependencies (single-table scan)
-	depRows, err := s.queryContext(ctx, `
-		SELECT depends_on_id, issue_id FROM dependencies
-		WHERE type = 'parent-child'
-	`)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get parent-child deps: %w", err)
-	}
-	// Map: parent_id -> list of child IDs
-	epicChildMap := make(map[string][]string)
-	epicSet := make(map[string]bool, len(epicIDs))
-	for _, id := range epicIDs {
-		epicSet[id] = true
-	}
-	for depRows.Next() {
-		var parentID, childID string
-		if err := depRows.Scan(&parentID, &childID); err != nil {
-			_ = depRows.Close() // Best effort cleanup on error path
-			return nil, wrapScanError("get epics: scan parent-child dep", err)
-		}
-		if epicSet[parentID] {
-			epicChildMap[parentID] = append(epicChildMap[parentID], childID)
-		}
-	}
-	_ = depRows.Close() // Redundant close for safety (rows already iterated)
-
-	// Step 3: Batch-fetch statuses for all child issues across all epics
-	allChildIDs := make([]string, 0)
-	for _, children := range epicChildMap {
-		allChildIDs = append(allChildIDs, children...)
-	}
-	childStatusMap := make(map[string]string)
-	if len(allChildIDs) > 0 {
-		// Check both issues and wisps tables for child statuses (bd-w2w)
-		// Uses batched IN clauses (queryBatchSize) to avoid full table scans on Dolt.
-		for _, table := range []string{"issues", "wisps"} {
-			for start := 0; start < len(allChildIDs); start += queryBatchSize {
-				end := start + queryBatchSize
-				if end > len(allChildIDs) {
-					end = len(allChildIDs)
-				}
-				batch := allChildIDs[start:end]
-				placeholders, args := doltBuildSQLInClause(batch)
-
-				// nolint:gosec // G201: table is hardcoded, placeholders contains only ? markers
-				statusQuery := fmt.Sprintf("SELECT id, status FROM %s WHERE id IN (%s)", table, placeholders)
-				statusRows, err := s.queryContext(ctx, statusQuery, args...)
-				if err != nil {
-					if isTableNotExistError(err) {
-						break // wisps table may not exist on pre-migration databases (GH#2271)
-					}
-					return nil, fmt.Errorf("failed to batch-fetch child statuses from %s: %w", table, err)
-				}
-				for statusRows.Next() {
-					var id, status string
-					if err := statusRows.Scan(&id, &status); err != nil {
-						_ = statusRows.Close()
-						return nil, wrapScanError("get epics: scan child status", err)
-					}
-					childStatusMap[id] = status
-				}
-				_ = statusRows.Close()
-			}
-		}
-	}
-
-	// Step 4: Batch-fetch all epic issues
-	epicsWithChildren := make([]string, 0)
-	for _, epicID := range epicIDs {
-		if len(epicChildMap[epicID]) > 0 {
-			epicsWithChildren = append(epicsWithChildren, epicID)
-		}
-	}
-	epicIssues, err := s.GetIssuesByIDs(ctx, epicsWithChildren)
-	if err != nil {
-		return nil, fmt.Errorf("failed to batch-fetch epic issues: %w", err)
-	}
-	epicIssueMap := make(map[string]*types.Issue, len(epicIssues))
-	for _, issue := range epicIssues {
-		epicIssueMap[issue.ID] = issue
-	}
-
-	// Step 5: Build results from cached data
-	var results []*types.EpicStatus
-	for _, epicID := range epicIDs {
-		children := epicChildMap[epicID]
-		if len(children) == 0 {
-			continue
-		}
-
-		issue, ok := epicIssueMap[epicID]
-		if !ok || issue == nil {
-			continue
-		}
-
-		totalChildren := len(children)
-		closedChildren := 0
-		for _, childID := range children {
-			if status, ok := childStatusMap[childID]; ok && types.Status(status) == types.StatusClosed {
-				closedChildren++
-			}
-		}
-
-		results = append(results, &types.EpicStatus{
-			Epic:             issue,
-			TotalChildren:    totalChildren,
-			ClosedChildren:   closedChildren,
-			EligibleForClose: totalChildren > 0 && totalChildren == closedChildren,
-		})
-	}
-
-	return results, nil
+	var result []*types.EpicStatus
+	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = issueops.GetEpicsEligibleForClosureInTx(ctx, tx)
+		return err
+	})
+	return result, err
 }
 
 // GetStaleIssues returns issues that haven't been updated recently
@@ -610,6 +559,16 @@ func (s *DoltStore) GetStatistics(ctx context.Context) (*types.Statistics, error
 		return nil, fmt.Errorf("failed to get statistics: %w", err)
 	}
 
+	// Per-status breakdown, including custom statuses.
+	err = s.withReadTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		stats.ByStatus, err = issueops.CountIssuesByStatusInTx(ctx, tx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statistics: %w", err)
+	}
+
 	// Blocked count: reuse computeBlockedIDs which caches the result across
 	// GetReadyWork and GetStatistics calls within the same CLI invocation.
 	var blockedCount int
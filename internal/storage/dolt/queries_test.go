@@ -1260,6 +1260,12 @@ func TestGetStatistics_CountsByStatus(t *testing.T) {
 	if stats.ClosedIssues != 2 {
 		t.Errorf("expected 2 closed issues, got %d", stats.ClosedIssues)
 	}
+	if got := stats.ByStatus[types.StatusOpen]; got != 2 {
+		t.Errorf("expected ByStatus[open] = 2, got %d", got)
+	}
+	if got := stats.ByStatus[types.StatusClosed]; got != 2 {
+		t.Errorf("expected ByStatus[closed] = 2, got %d", got)
+	}
 }
 
 func TestGetStatistics_BlockedCount(t *testing.T) {
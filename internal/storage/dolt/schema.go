@@ -3,7 +3,7 @@ package dolt
 // currentSchemaVersion is bumped whenever the schema or migrations change.
 // initSchemaOnDB checks this against the stored version and skips re-initialization
 // when they match, avoiding ~20 DDL statements per bd invocation.
-const currentSchemaVersion = 8
+const currentSchemaVersion = 9
 
 // schema defines the MySQL-compatible database schema for Dolt.
 const schema = `
@@ -82,6 +82,7 @@ CREATE TABLE IF NOT EXISTS issues (
     defer_until DATETIME,
     INDEX idx_issues_status (status),
     INDEX idx_issues_priority (priority),
+    INDEX idx_issues_status_priority (status, priority),
     INDEX idx_issues_issue_type (issue_type),
     INDEX idx_issues_assignee (assignee),
     INDEX idx_issues_created_at (created_at),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -38,17 +39,17 @@ func (s *DoltStore) AsOf(ctx context.Context, issueID string, ref string) (*type
 // Implements storage.VersionedStorage.
 func (s *DoltStore) Diff(ctx context.Context, fromRef, toRef string) ([]*storage.DiffEntry, error) {
 	// Validate refs to prevent SQL injection
-	if err := validateRef(fromRef); err != nil {
+	if err := issueops.ValidateRef(fromRef); err != nil {
 		return nil, fmt.Errorf("invalid fromRef: %w", err)
 	}
-	if err := validateRef(toRef); err != nil {
+	if err := issueops.ValidateRef(toRef); err != nil {
 		return nil, fmt.Errorf("invalid toRef: %w", err)
 	}
 
 	// Query issue-level diffs using dolt_diff table function
 	// Syntax: dolt_diff(from_ref, to_ref, 'table_name')
 	// Note: refs are validated above
-	// nolint:gosec // G201: refs validated by validateRef()
+	// nolint:gosec // G201: refs validated by issueops.ValidateRef()
 	query := fmt.Sprintf(`
 		SELECT
 			COALESCE(from_id, '') as from_id,
@@ -193,7 +194,7 @@ func (s *DoltStore) CommitExists(ctx context.Context, commitHash string) (bool,
 	}
 
 	// Validate format to reject malformed input
-	if err := validateRef(commitHash); err != nil {
+	if err := issueops.ValidateRef(commitHash); err != nil {
 		return false, nil
 	}
 
@@ -3,7 +3,6 @@ package dolt
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -184,91 +183,39 @@ func (s *DoltStore) getWispLabels(ctx context.Context, issueID string) ([]string
 	return labels, rows.Err()
 }
 
-// updateWisp updates fields on a wisp in the wisps table.
-func (s *DoltStore) updateWisp(ctx context.Context, id string, updates map[string]interface{}, _ string) error {
-	// Get old wisp for closed_at auto-management
-	oldWisp, err := s.getWisp(ctx, id)
+// updateWisp updates fields on a wisp in the wisps table, recording the same
+// events in wisp_events that UpdateIssue records for permanent issues.
+// Wisps are dolt-ignored, so there is nothing to stage or commit.
+func (s *DoltStore) updateWisp(ctx context.Context, id string, updates map[string]interface{}, actor string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get wisp for update: %w", err)
-	}
-
-	setClauses := []string{"updated_at = ?"}
-	args := []interface{}{time.Now().UTC()}
-
-	for key, value := range updates {
-		if !isAllowedUpdateField(key) {
-			return fmt.Errorf("invalid field for update: %s", key)
-		}
-		columnName := key
-		if key == "wisp" {
-			columnName = "ephemeral"
-		}
-		setClauses = append(setClauses, fmt.Sprintf("`%s` = ?", columnName))
-		if key == "waiters" {
-			waitersJSON, err := json.Marshal(value)
-			if err != nil {
-				return fmt.Errorf("invalid waiters: %w", err)
-			}
-			args = append(args, string(waitersJSON))
-		} else if key == "metadata" {
-			metadataStr, err := storage.NormalizeMetadataValue(value)
-			if err != nil {
-				return fmt.Errorf("invalid metadata: %w", err)
-			}
-			args = append(args, metadataStr)
-		} else {
-			args = append(args, value)
-		}
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	// Auto-manage closed_at (set on close, clear on reopen)
-	setClauses, args = manageClosedAt(oldWisp, updates, setClauses, args)
-
-	args = append(args, id)
-
-	// nolint:gosec // G201: setClauses contains only column names
-	query := fmt.Sprintf("UPDATE wisps SET %s WHERE id = ?", strings.Join(setClauses, ", "))
-	_, err = s.execContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("failed to update wisp: %w", err)
+	if err := issueops.UpdateIssueInTx(ctx, tx, id, updates, actor); err != nil {
+		return err
 	}
-	return nil
+	return wrapTransactionError("commit update wisp", tx.Commit())
 }
 
 // closeWisp closes a wisp in the wisps table.
 func (s *DoltStore) closeWisp(ctx context.Context, id string, reason string, actor string, session string) error {
-	now := time.Now().UTC()
-
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	result, err := tx.ExecContext(ctx, `
-		UPDATE wisps SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?, closed_by_session = ?
-		WHERE id = ?
-	`, types.StatusClosed, now, now, reason, session, id)
-	if err != nil {
-		return fmt.Errorf("failed to close wisp: %w", err)
+	if err := issueops.CloseIssueInTx(ctx, tx, id, reason, actor, session); err != nil {
+		return err
 	}
-
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-	if rows == 0 {
-		return fmt.Errorf("wisp not found: %s", id)
-	}
-
-	if err := issueops.RecordEventInTable(ctx, tx, "wisp_events", id, types.EventClosed, actor, reason); err != nil {
-		return fmt.Errorf("failed to record event: %w", err)
-	}
-
 	return wrapTransactionError("commit close wisp", tx.Commit())
 }
 
-// deleteWisp permanently removes a wisp and its related data.
+// deleteWisp permanently removes a wisp and its related data. Used by
+// PromoteFromEphemeral once the wisp has been copied into the issues table,
+// so edges pointing at the promoted ID are left in place.
 func (s *DoltStore) deleteWisp(ctx context.Context, id string) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -276,31 +223,9 @@ func (s *DoltStore) deleteWisp(ctx context.Context, id string) error {
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	// Delete from auxiliary tables
-	for _, table := range []string{"wisp_dependencies", "wisp_events", "wisp_comments", "wisp_labels"} {
-		if table == "wisp_dependencies" {
-			_, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE issue_id = ? OR depends_on_id = ?", table), id, id) //nolint:gosec // G201: table is hardcoded
-		} else {
-			_, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE issue_id = ?", table), id) //nolint:gosec // G201: table is hardcoded
-		}
-		if err != nil {
-			return fmt.Errorf("failed to delete from %s: %w", table, err)
-		}
+	if err := issueops.DeleteIssueInTx(ctx, tx, id); err != nil {
+		return err
 	}
-
-	result, err := tx.ExecContext(ctx, "DELETE FROM wisps WHERE id = ?", id)
-	if err != nil {
-		return fmt.Errorf("failed to delete wisp: %w", err)
-	}
-
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-	if rows == 0 {
-		return fmt.Errorf("wisp not found: %s", id)
-	}
-
 	return wrapTransactionError("commit delete wisp", tx.Commit())
 }
 
@@ -625,7 +550,7 @@ func (s *DoltStore) addWispDependency(ctx context.Context, dep *types.Dependency
 			return fmt.Errorf("failed to check for dependency cycle: %w", err)
 		}
 		if reachable > 0 {
-			return fmt.Errorf("adding dependency would create a cycle")
+			return issueops.WouldCreateCycleError(ctx, tx, dep, []string{"dependencies", "wisp_dependencies"})
 		}
 	}
 
@@ -656,22 +581,23 @@ func (s *DoltStore) addWispDependency(ctx context.Context, dep *types.Dependency
 	`, dep.IssueID, dep.DependsOnID, dep.Type, actor, metadata, dep.ThreadID); err != nil {
 		return fmt.Errorf("failed to add wisp dependency: %w", err)
 	}
+	if err := issueops.RecordDependencyAddedInTx(ctx, tx, "wisp_events", dep, actor); err != nil {
+		return err
+	}
 
 	return wrapTransactionError("commit add wisp dependency", tx.Commit())
 }
 
 // removeWispDependency removes a dependency from wisp_dependencies.
-func (s *DoltStore) removeWispDependency(ctx context.Context, issueID, dependsOnID string) error {
+func (s *DoltStore) removeWispDependency(ctx context.Context, issueID, dependsOnID, actor string) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	if _, err := tx.ExecContext(ctx, `
-		DELETE FROM wisp_dependencies WHERE issue_id = ? AND depends_on_id = ?
-	`, issueID, dependsOnID); err != nil {
-		return fmt.Errorf("failed to remove wisp dependency: %w", err)
+	if err := issueops.RemoveDependencyInTx(ctx, tx, issueID, dependsOnID, actor); err != nil {
+		return err
 	}
 
 	return wrapTransactionError("commit remove wisp dependency", tx.Commit())
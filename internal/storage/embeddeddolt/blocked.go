@@ -6,11 +6,344 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/steveyegge/beads/internal/storage/issueops"
 	"github.com/steveyegge/beads/internal/types"
 )
 
+// IsBlocked reports whether an issue has open blockers, and if so which ones.
+// Blocked status comes from computeBlockedIDs so it stays consistent with
+// ready-work calculation (GH#1524). Blockers whose target issue no longer
+// exists are ignored.
+func (s *EmbeddedDoltStore) IsBlocked(ctx context.Context, issueID string) (bool, []string, error) {
+	var blocked bool
+	var blockers []string
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		blockedIDs, err := computeBlockedIDs(ctx, tx, true)
+		if err != nil {
+			return err
+		}
+		for _, id := range blockedIDs {
+			if id == issueID {
+				blocked = true
+				break
+			}
+		}
+		if !blocked {
+			return nil
+		}
+
+		// Two sequential queries instead of a JOIN: the embedded engine's
+		// merge join can panic on this shape (see bd-o23 for the same
+		// pattern in DoltStore.GetNewlyUnblockedByClose).
+		_, _, _, depTable := issueops.WispTableRouting(issueops.IsActiveWispInTx(ctx, tx, issueID))
+		//nolint:gosec // G201: depTable is from WispTableRouting
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+			SELECT depends_on_id, type FROM %s
+			WHERE issue_id = ? AND type IN ('blocks', 'waits-for', 'conditional-blocks')
+		`, depTable), issueID)
+		if err != nil {
+			return fmt.Errorf("is blocked: blocker deps: %w", err)
+		}
+		depTypes := make(map[string]string)
+		var targetIDs []string
+		for rows.Next() {
+			var id, depType string
+			if err := rows.Scan(&id, &depType); err != nil {
+				rows.Close()
+				return fmt.Errorf("is blocked: scan blocker dep: %w", err)
+			}
+			depTypes[id] = depType
+			targetIDs = append(targetIDs, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("is blocked: blocker dep rows: %w", err)
+		}
+
+		// Missing targets (deleted issues) are not active and so never block.
+		activeIDs, err := getActiveIDs(ctx, tx, []string{"issues", "wisps"})
+		if err != nil {
+			return err
+		}
+		for _, id := range targetIDs {
+			if !activeIDs[id] {
+				continue
+			}
+			if depType := depTypes[id]; depType != string(types.DepBlocks) {
+				blockers = append(blockers, id+" ("+depType+")")
+			} else {
+				blockers = append(blockers, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, nil, err
+	}
+	return blocked, blockers, nil
+}
+
+// GetNewlyUnblockedByClose returns the active issues that were blocked by
+// closedIssueID and have no other active blockers left. Call it after the
+// close has been written. Returns an empty slice when nothing was unblocked.
+func (s *EmbeddedDoltStore) GetNewlyUnblockedByClose(ctx context.Context, closedIssueID string) ([]*types.Issue, error) {
+	unblocked := []*types.Issue{}
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		depTables := []string{"dependencies", "wisp_dependencies"}
+		activeIDs, err := getActiveIDs(ctx, tx, []string{"issues", "wisps"})
+		if err != nil {
+			return err
+		}
+
+		// Step 1: active dependents of the closed issue. Sequential queries
+		// rather than a JOIN, as in IsBlocked.
+		var candidateIDs []string
+		seen := make(map[string]bool)
+		for _, depTable := range depTables {
+			//nolint:gosec // G201: depTable is hardcoded to "dependencies" or "wisp_dependencies"
+			rows, err := tx.QueryContext(ctx, fmt.Sprintf(
+				`SELECT issue_id FROM %s WHERE depends_on_id = ? AND type = 'blocks'`, depTable), closedIssueID)
+			if err != nil {
+				return fmt.Errorf("get newly unblocked: dependents from %s: %w", depTable, err)
+			}
+			for rows.Next() {
+				var id string
+				if err := rows.Scan(&id); err != nil {
+					rows.Close()
+					return fmt.Errorf("get newly unblocked: scan dependent: %w", err)
+				}
+				if activeIDs[id] && !seen[id] {
+					seen[id] = true
+					candidateIDs = append(candidateIDs, id)
+				}
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return fmt.Errorf("get newly unblocked: dependent rows from %s: %w", depTable, err)
+			}
+		}
+		if len(candidateIDs) == 0 {
+			return nil
+		}
+
+		// Step 2: drop candidates that still have another active blocker.
+		args := make([]any, 0, len(candidateIDs)+1)
+		for _, id := range candidateIDs {
+			args = append(args, id)
+		}
+		args = append(args, closedIssueID)
+		placeholders := strings.Repeat("?,", len(candidateIDs))
+		placeholders = placeholders[:len(placeholders)-1]
+
+		stillBlocked := make(map[string]bool)
+		for _, depTable := range depTables {
+			//nolint:gosec // G201: depTable is hardcoded and placeholders contains only ? markers
+			rows, err := tx.QueryContext(ctx, fmt.Sprintf(
+				`SELECT issue_id, depends_on_id FROM %s
+				 WHERE issue_id IN (%s) AND type = 'blocks' AND depends_on_id != ?`, depTable, placeholders), args...)
+			if err != nil {
+				return fmt.Errorf("get newly unblocked: remaining blockers from %s: %w", depTable, err)
+			}
+			for rows.Next() {
+				var issueID, blockerID string
+				if err := rows.Scan(&issueID, &blockerID); err != nil {
+					rows.Close()
+					return fmt.Errorf("get newly unblocked: scan remaining blocker: %w", err)
+				}
+				if activeIDs[blockerID] {
+					stillBlocked[issueID] = true
+				}
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return fmt.Errorf("get newly unblocked: remaining blocker rows from %s: %w", depTable, err)
+			}
+		}
+
+		var unblockedIDs []string
+		for _, id := range candidateIDs {
+			if !stillBlocked[id] {
+				unblockedIDs = append(unblockedIDs, id)
+			}
+		}
+		if len(unblockedIDs) == 0 {
+			return nil
+		}
+		issues, err := issueops.GetIssuesInOrderInTx(ctx, tx, unblockedIDs)
+		if err != nil {
+			return fmt.Errorf("get newly unblocked: %w", err)
+		}
+		unblocked = append(unblocked, issues...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return unblocked, nil
+}
+
+// GetBlockedIssues returns active issues that are blocked, along with the
+// IDs of the active issues blocking them. Children of blocked parents are
+// included with their parent as the blocker (GH#1495). Like IsBlocked, this
+// uses single-table queries and joins the results in Go.
+func (s *EmbeddedDoltStore) GetBlockedIssues(ctx context.Context, filter types.WorkFilter) ([]*types.BlockedIssue, error) {
+	var results []*types.BlockedIssue
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		results, err = getBlockedIssuesInTx(ctx, tx, filter)
+		return err
+	})
+	return results, err
+}
+
+func getBlockedIssuesInTx(ctx context.Context, tx *sql.Tx, filter types.WorkFilter) ([]*types.BlockedIssue, error) {
+	issueTables := []string{"issues", "wisps"}
+	depTables := []string{"dependencies", "wisp_dependencies"}
+
+	activeIDs, err := getActiveIDs(ctx, tx, issueTables)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedIDList, err := computeBlockedIDs(ctx, tx, true)
+	if err != nil {
+		return nil, err
+	}
+	blockedSet := make(map[string]bool, len(blockedIDList))
+	for _, id := range blockedIDList {
+		blockedSet[id] = true
+	}
+
+	// Children of blocked parents are excluded from ready work, so they
+	// belong in blocked output too (GH#1495).
+	childToParent, err := getChildrenWithParents(ctx, tx, blockedIDList, depTables)
+	if err != nil {
+		return nil, err
+	}
+	for childID := range childToParent {
+		if activeIDs[childID] {
+			blockedSet[childID] = true
+		}
+	}
+
+	allDeps, err := getBlockingDeps(ctx, tx, depTables)
+	if err != nil {
+		return nil, err
+	}
+	blockerMap := make(map[string][]string)
+	for _, rec := range allDeps {
+		if blockedSet[rec.issueID] && activeIDs[rec.dependsOnID] {
+			blockerMap[rec.issueID] = append(blockerMap[rec.issueID], rec.dependsOnID)
+		}
+	}
+	for childID, parentID := range childToParent {
+		if !blockedSet[childID] {
+			continue
+		}
+		if _, hasDirectBlocker := blockerMap[childID]; !hasDirectBlocker {
+			blockerMap[childID] = []string{parentID}
+		}
+	}
+
+	// Restrict to children of the requested parent, including dotted-ID
+	// children such as "parent.1.2" (GH#2009).
+	var parentChildSet map[string]bool
+	if filter.ParentID != nil {
+		parentID := *filter.ParentID
+		children, err := getChildrenWithParents(ctx, tx, []string{parentID}, depTables)
+		if err != nil {
+			return nil, err
+		}
+		parentChildSet = make(map[string]bool, len(children))
+		for childID := range children {
+			parentChildSet[childID] = true
+		}
+		for id := range blockerMap {
+			if strings.HasPrefix(id, parentID+".") {
+				parentChildSet[id] = true
+			}
+		}
+	}
+
+	blockedIDs := make([]string, 0, len(blockerMap))
+	for id := range blockerMap {
+		if parentChildSet == nil || parentChildSet[id] {
+			blockedIDs = append(blockedIDs, id)
+		}
+	}
+	issues, err := issueops.GetIssuesByIDsInTx(ctx, tx, blockedIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get blocked issues: %w", err)
+	}
+
+	var results []*types.BlockedIssue
+	for _, issue := range issues {
+		if !filter.Matches(issue) {
+			continue
+		}
+		blockerIDs := blockerMap[issue.ID]
+		results = append(results, &types.BlockedIssue{
+			Issue:          *issue,
+			BlockedByCount: len(blockerIDs),
+			BlockedBy:      blockerIDs,
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Priority != results[j].Priority {
+			return results[i].Priority < results[j].Priority
+		}
+		return results[i].CreatedAt.After(results[j].CreatedAt)
+	})
+	if filter.Limit > 0 && len(results) > filter.Limit {
+		results = results[:filter.Limit]
+	}
+	return results, nil
+}
+
+// getChildrenWithParents returns a map of childID -> parentID for direct
+// (parent-child) children of the given parent IDs. Uses a batched IN query
+// per dep table to avoid N+1 round-trips.
+func getChildrenWithParents(ctx context.Context, tx *sql.Tx, parentIDs []string, depTables []string) (map[string]string, error) {
+	result := make(map[string]string)
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+
+	args := make([]any, len(parentIDs))
+	for i, id := range parentIDs {
+		args[i] = id
+	}
+	placeholders := strings.Repeat("?,", len(args))
+	placeholders = placeholders[:len(placeholders)-1]
+
+	for _, depTable := range depTables {
+		//nolint:gosec // G201: depTable is hardcoded to "dependencies" or "wisp_dependencies"
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf(
+			`SELECT issue_id, depends_on_id FROM %s
+			 WHERE type = 'parent-child' AND depends_on_id IN (%s)`, depTable, placeholders), args...)
+		if err != nil {
+			return nil, fmt.Errorf("get blocked issues: children from %s: %w", depTable, err)
+		}
+		for rows.Next() {
+			var childID, parentID string
+			if err := rows.Scan(&childID, &parentID); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("get blocked issues: scan child: %w", err)
+			}
+			result[childID] = parentID
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("get blocked issues: child rows from %s: %w", depTable, err)
+		}
+	}
+	return result, nil
+}
+
 // computeBlockedIDs returns the set of issue IDs that are blocked by active
 // issues. The logic mirrors DoltStore.computeBlockedIDs but without caching
 // (each call runs inside a short-lived withConn transaction).
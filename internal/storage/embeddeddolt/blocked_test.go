@@ -0,0 +1,222 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestIsBlocked(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "ib")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "ib-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "ib-open", Title: "Open blocker", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "ib-closed", Title: "Closed blocker", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask},
+		{ID: "ib-free", Title: "Free", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	for _, target := range []string{"ib-open", "ib-closed"} {
+		dep := &types.Dependency{IssueID: "ib-a", DependsOnID: target, Type: types.DepBlocks}
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency -> %s: %v", target, err)
+		}
+	}
+	dep := &types.Dependency{IssueID: "ib-free", DependsOnID: "ib-closed", Type: types.DepBlocks}
+	if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+		t.Fatalf("AddDependency ib-free: %v", err)
+	}
+
+	t.Run("open_blocker", func(t *testing.T) {
+		blocked, blockers, err := te.store.IsBlocked(ctx, "ib-a")
+		if err != nil {
+			t.Fatalf("IsBlocked: %v", err)
+		}
+		if !blocked {
+			t.Fatal("expected ib-a to be blocked")
+		}
+		if len(blockers) != 1 || blockers[0] != "ib-open" {
+			t.Errorf("blockers: got %v, want [ib-open]", blockers)
+		}
+	})
+
+	t.Run("only_closed_blockers", func(t *testing.T) {
+		blocked, blockers, err := te.store.IsBlocked(ctx, "ib-free")
+		if err != nil {
+			t.Fatalf("IsBlocked: %v", err)
+		}
+		if blocked || len(blockers) != 0 {
+			t.Errorf("expected not blocked, got blocked=%v blockers=%v", blocked, blockers)
+		}
+	})
+
+	t.Run("no_dependencies", func(t *testing.T) {
+		blocked, blockers, err := te.store.IsBlocked(ctx, "ib-open")
+		if err != nil {
+			t.Fatalf("IsBlocked: %v", err)
+		}
+		if blocked || len(blockers) != 0 {
+			t.Errorf("expected not blocked, got blocked=%v blockers=%v", blocked, blockers)
+		}
+	})
+}
+
+func TestGetBlockedIssues(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "gb")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "gb-a", Title: "A", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, Assignee: "alice"},
+		{ID: "gb-b", Title: "B", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "gb-blocker", Title: "Blocker", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "gb-closed", Title: "Closed", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask},
+		{ID: "gb-child", Title: "Child", Status: types.StatusOpen, Priority: 3, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	for _, dep := range []*types.Dependency{
+		{IssueID: "gb-a", DependsOnID: "gb-blocker", Type: types.DepBlocks},
+		{IssueID: "gb-b", DependsOnID: "gb-blocker", Type: types.DepBlocks},
+		{IssueID: "gb-b", DependsOnID: "gb-closed", Type: types.DepBlocks},
+		{IssueID: "gb-child", DependsOnID: "gb-a", Type: types.DepParentChild},
+	} {
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency %s -> %s: %v", dep.IssueID, dep.DependsOnID, err)
+		}
+	}
+	if err := te.store.AddLabel(ctx, "gb-b", "backend", "tester"); err != nil {
+		t.Fatalf("AddLabel: %v", err)
+	}
+
+	t.Run("all_blocked", func(t *testing.T) {
+		blocked, err := te.store.GetBlockedIssues(ctx, types.WorkFilter{})
+		if err != nil {
+			t.Fatalf("GetBlockedIssues: %v", err)
+		}
+		var got []string
+		for _, b := range blocked {
+			got = append(got, b.ID)
+		}
+		if strings.Join(got, ",") != "gb-a,gb-b,gb-child" {
+			t.Fatalf("blocked IDs: got %v, want [gb-a gb-b gb-child]", got)
+		}
+		// Closed blockers are not reported.
+		if blocked[1].BlockedByCount != 1 || blocked[1].BlockedBy[0] != "gb-blocker" {
+			t.Errorf("gb-b blockers: got %v", blocked[1].BlockedBy)
+		}
+		// Children of blocked parents report the parent as their blocker.
+		if len(blocked[2].BlockedBy) != 1 || blocked[2].BlockedBy[0] != "gb-a" {
+			t.Errorf("gb-child blockers: got %v", blocked[2].BlockedBy)
+		}
+	})
+
+	t.Run("assignee_filter", func(t *testing.T) {
+		assignee := "alice"
+		blocked, err := te.store.GetBlockedIssues(ctx, types.WorkFilter{Assignee: &assignee})
+		if err != nil {
+			t.Fatalf("GetBlockedIssues: %v", err)
+		}
+		if len(blocked) != 1 || blocked[0].ID != "gb-a" {
+			t.Errorf("got %d results, want only gb-a", len(blocked))
+		}
+	})
+
+	t.Run("label_filter", func(t *testing.T) {
+		blocked, err := te.store.GetBlockedIssues(ctx, types.WorkFilter{Labels: []string{"backend"}})
+		if err != nil {
+			t.Fatalf("GetBlockedIssues: %v", err)
+		}
+		if len(blocked) != 1 || blocked[0].ID != "gb-b" {
+			t.Errorf("got %d results, want only gb-b", len(blocked))
+		}
+	})
+
+	t.Run("exclude_labels_filter", func(t *testing.T) {
+		blocked, err := te.store.GetBlockedIssues(ctx, types.WorkFilter{ExcludeLabels: []string{"backend"}})
+		if err != nil {
+			t.Fatalf("GetBlockedIssues: %v", err)
+		}
+		for _, b := range blocked {
+			if b.ID == "gb-b" {
+				t.Errorf("gb-b has an excluded label but was returned")
+			}
+		}
+		if len(blocked) != 2 {
+			t.Errorf("got %d results, want 2", len(blocked))
+		}
+	})
+
+	t.Run("parent_filter", func(t *testing.T) {
+		parent := "gb-a"
+		blocked, err := te.store.GetBlockedIssues(ctx, types.WorkFilter{ParentID: &parent})
+		if err != nil {
+			t.Fatalf("GetBlockedIssues: %v", err)
+		}
+		if len(blocked) != 1 || blocked[0].ID != "gb-child" {
+			t.Errorf("got %d results, want only gb-child", len(blocked))
+		}
+	})
+}
+
+func TestGetNewlyUnblockedByClose(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "nu")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "nu-target", Title: "Target", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "nu-other", Title: "Other blocker", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "nu-free", Title: "Freed", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "nu-still", Title: "Still blocked", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "nu-done", Title: "Already closed", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	for _, dep := range []*types.Dependency{
+		{IssueID: "nu-free", DependsOnID: "nu-target", Type: types.DepBlocks},
+		{IssueID: "nu-still", DependsOnID: "nu-target", Type: types.DepBlocks},
+		{IssueID: "nu-still", DependsOnID: "nu-other", Type: types.DepBlocks},
+		{IssueID: "nu-done", DependsOnID: "nu-target", Type: types.DepBlocks},
+	} {
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency %s -> %s: %v", dep.IssueID, dep.DependsOnID, err)
+		}
+	}
+	te.exec(t, ctx, "UPDATE issues SET status = 'closed' WHERE id = ?", "nu-target")
+
+	t.Run("only_fully_unblocked", func(t *testing.T) {
+		unblocked, err := te.store.GetNewlyUnblockedByClose(ctx, "nu-target")
+		if err != nil {
+			t.Fatalf("GetNewlyUnblockedByClose: %v", err)
+		}
+		if len(unblocked) != 1 || unblocked[0].ID != "nu-free" {
+			t.Errorf("got %d issues, want only nu-free", len(unblocked))
+		}
+	})
+
+	t.Run("nothing_unblocked", func(t *testing.T) {
+		unblocked, err := te.store.GetNewlyUnblockedByClose(ctx, "nu-free")
+		if err != nil {
+			t.Fatalf("GetNewlyUnblockedByClose: %v", err)
+		}
+		if unblocked == nil || len(unblocked) != 0 {
+			t.Errorf("expected empty non-nil slice, got %v", unblocked)
+		}
+	})
+}
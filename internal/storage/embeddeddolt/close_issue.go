@@ -0,0 +1,23 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+)
+
+func (s *EmbeddedDoltStore) CloseIssue(ctx context.Context, id string, reason string, actor string, session string) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		return issueops.CloseIssueInTx(ctx, tx, id, reason, actor, session)
+	})
+}
+
+func (s *EmbeddedDoltStore) CloseIssues(ctx context.Context, ids []string, reason string, actor string, session string) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		_, err := issueops.CloseIssuesInTx(ctx, tx, ids, reason, actor, session)
+		return err
+	})
+}
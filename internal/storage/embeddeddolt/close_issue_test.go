@@ -0,0 +1,178 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestCloseIssue(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("moves_between_status_filters", func(t *testing.T) {
+		te := newTestEnv(t, "cl")
+		ctx := t.Context()
+
+		issue := &types.Issue{ID: "cl-a", Title: "close me", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+
+		if err := te.store.CloseIssue(ctx, "cl-a", "done", "tester", "sess-1"); err != nil {
+			t.Fatalf("CloseIssue: %v", err)
+		}
+
+		open := types.StatusOpen
+		openIssues, err := te.store.SearchIssues(ctx, "", types.IssueFilter{Status: &open})
+		if err != nil {
+			t.Fatalf("SearchIssues open: %v", err)
+		}
+		if len(openIssues) != 0 {
+			t.Errorf("open search: got %d issues, want 0", len(openIssues))
+		}
+
+		closed := types.StatusClosed
+		closedIssues, err := te.store.SearchIssues(ctx, "", types.IssueFilter{Status: &closed})
+		if err != nil {
+			t.Fatalf("SearchIssues closed: %v", err)
+		}
+		if len(closedIssues) != 1 || closedIssues[0].ID != "cl-a" {
+			t.Fatalf("closed search: got %v, want [cl-a]", closedIssues)
+		}
+
+		got, err := te.store.GetIssue(ctx, "cl-a")
+		if err != nil {
+			t.Fatalf("GetIssue: %v", err)
+		}
+		if got.ClosedAt == nil {
+			t.Error("ClosedAt not set")
+		}
+		if got.CloseReason != "done" {
+			t.Errorf("CloseReason: got %q, want %q", got.CloseReason, "done")
+		}
+		var session string
+		te.queryScalar(t, ctx, "SELECT closed_by_session FROM issues WHERE id = ?", []any{"cl-a"}, &session)
+		if session != "sess-1" {
+			t.Errorf("closed_by_session: got %q, want %q", session, "sess-1")
+		}
+		te.assertEventCount(t, ctx, "events", "cl-a", "closed", 1)
+	})
+
+	t.Run("wisp", func(t *testing.T) {
+		te := newTestEnv(t, "cw")
+		ctx := t.Context()
+
+		wisp := &types.Issue{ID: "cw-wisp1", Title: "wisp", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true}
+		if err := te.store.CreateIssue(ctx, wisp, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+
+		if err := te.store.CloseIssue(ctx, "cw-wisp1", "done", "tester", ""); err != nil {
+			t.Fatalf("CloseIssue: %v", err)
+		}
+
+		var status string
+		te.queryScalar(t, ctx, "SELECT status FROM wisps WHERE id = ?", []any{"cw-wisp1"}, &status)
+		if status != string(types.StatusClosed) {
+			t.Errorf("wisp status: got %q, want %q", status, types.StatusClosed)
+		}
+		te.assertEventCount(t, ctx, "wisp_events", "cw-wisp1", "closed", 1)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		te := newTestEnv(t, "cn")
+		ctx := t.Context()
+
+		err := te.store.CloseIssue(ctx, "cn-missing", "done", "tester", "")
+		if !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestCloseIssues(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("closes_all_and_skips_closed", func(t *testing.T) {
+		te := newTestEnv(t, "cb")
+		ctx := t.Context()
+
+		for _, issue := range []*types.Issue{
+			{ID: "cb-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+			{ID: "cb-b", Title: "B", Status: types.StatusInProgress, Priority: 2, IssueType: types.TypeTask},
+			{ID: "cb-c", Title: "C", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		} {
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+			}
+		}
+		if err := te.store.CloseIssue(ctx, "cb-c", "earlier", "tester", "sess-0"); err != nil {
+			t.Fatalf("CloseIssue: %v", err)
+		}
+
+		if err := te.store.CloseIssues(ctx, []string{"cb-a", "cb-b", "cb-c"}, "swarm done", "tester", "sess-1"); err != nil {
+			t.Fatalf("CloseIssues: %v", err)
+		}
+
+		for _, id := range []string{"cb-a", "cb-b", "cb-c"} {
+			got, err := te.store.GetIssue(ctx, id)
+			if err != nil {
+				t.Fatalf("GetIssue %s: %v", id, err)
+			}
+			if got.Status != types.StatusClosed {
+				t.Errorf("%s status = %q, want closed", id, got.Status)
+			}
+		}
+		te.assertEventCount(t, ctx, "events", "cb-a", "closed", 1)
+		te.assertEventCount(t, ctx, "events", "cb-b", "closed", 1)
+		// Already closed: no second closed event and the original reason is kept.
+		te.assertEventCount(t, ctx, "events", "cb-c", "closed", 1)
+		var reason string
+		te.queryScalar(t, ctx, "SELECT close_reason FROM issues WHERE id = ?", []any{"cb-c"}, &reason)
+		if reason != "earlier" {
+			t.Errorf("cb-c close_reason = %q, want %q", reason, "earlier")
+		}
+	})
+
+	t.Run("missing_ids_roll_back_batch", func(t *testing.T) {
+		te := newTestEnv(t, "cm")
+		ctx := t.Context()
+
+		for _, issue := range []*types.Issue{
+			{ID: "cm-open", Title: "Open", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+			{ID: "cm-done", Title: "Done", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		} {
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+			}
+		}
+		if err := te.store.CloseIssue(ctx, "cm-done", "earlier", "tester", "sess-0"); err != nil {
+			t.Fatalf("CloseIssue: %v", err)
+		}
+
+		err := te.store.CloseIssues(ctx, []string{"cm-open", "cm-missing", "cm-done", "cm-gone"}, "swarm done", "tester", "sess-1")
+		if !errors.Is(err, storage.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+		var missingErr *storage.MissingIssuesError
+		if !errors.As(err, &missingErr) {
+			t.Fatalf("expected *storage.MissingIssuesError, got %T", err)
+		}
+		if len(missingErr.IDs) != 2 || missingErr.IDs[0] != "cm-missing" || missingErr.IDs[1] != "cm-gone" {
+			t.Errorf("missing IDs = %v, want [cm-missing cm-gone]", missingErr.IDs)
+		}
+
+		got, err := te.store.GetIssue(ctx, "cm-open")
+		if err != nil {
+			t.Fatalf("GetIssue: %v", err)
+		}
+		if got.Status != types.StatusOpen {
+			t.Errorf("cm-open status = %q, want open after failed batch", got.Status)
+		}
+		te.assertEventCount(t, ctx, "events", "cm-open", "closed", 0)
+	})
+}
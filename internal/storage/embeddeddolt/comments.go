@@ -0,0 +1,52 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func (s *EmbeddedDoltStore) AddComment(ctx context.Context, issueID, actor, comment string) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		return issueops.AddCommentEventInTx(ctx, tx, issueID, actor, comment)
+	})
+}
+
+func (s *EmbeddedDoltStore) AddIssueComment(ctx context.Context, issueID, author, text string) (*types.Comment, error) {
+	return s.ImportIssueComment(ctx, issueID, author, text, time.Now().UTC())
+}
+
+func (s *EmbeddedDoltStore) ImportIssueComment(ctx context.Context, issueID, author, text string, createdAt time.Time) (*types.Comment, error) {
+	var comment *types.Comment
+	err := s.withConn(ctx, true, func(tx *sql.Tx) error {
+		var err error
+		comment, err = issueops.ImportIssueCommentInTx(ctx, tx, issueID, author, text, createdAt)
+		return err
+	})
+	return comment, err
+}
+
+func (s *EmbeddedDoltStore) GetIssueComments(ctx context.Context, issueID string) ([]*types.Comment, error) {
+	var comments []*types.Comment
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		comments, err = issueops.GetIssueCommentsInTx(ctx, tx, issueID)
+		return err
+	})
+	return comments, err
+}
+
+func (s *EmbeddedDoltStore) GetCommentsForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Comment, error) {
+	var result map[string][]*types.Comment
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		result, err = issueops.GetCommentsForIssuesInTx(ctx, tx, issueIDs)
+		return err
+	})
+	return result, err
+}
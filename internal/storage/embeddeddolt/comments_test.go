@@ -0,0 +1,106 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestIssueComments(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "cm")
+	ctx := t.Context()
+
+	for _, id := range []string{"cm-a", "cm-b", "cm-none"} {
+		issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", id, err)
+		}
+	}
+
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tricky := "He said \"ship it\"\nthen left; DROP TABLE issues; --"
+	for _, c := range []struct {
+		issueID, text string
+		at            time.Time
+	}{
+		{"cm-a", "second", base.Add(time.Hour)},
+		{"cm-a", tricky, base},
+		{"cm-b", "only", base},
+	} {
+		if _, err := te.store.ImportIssueComment(ctx, c.issueID, "alice", c.text, c.at); err != nil {
+			t.Fatalf("ImportIssueComment(%s): %v", c.issueID, err)
+		}
+	}
+
+	t.Run("ordered_by_created_at", func(t *testing.T) {
+		comments, err := te.store.GetIssueComments(ctx, "cm-a")
+		if err != nil {
+			t.Fatalf("GetIssueComments: %v", err)
+		}
+		if len(comments) != 2 {
+			t.Fatalf("got %d comments, want 2", len(comments))
+		}
+		if comments[0].Text != tricky || comments[1].Text != "second" {
+			t.Errorf("texts: got [%q %q]", comments[0].Text, comments[1].Text)
+		}
+		if comments[0].Author != "alice" || !comments[0].CreatedAt.Equal(base) {
+			t.Errorf("first comment: got author=%q created_at=%v", comments[0].Author, comments[0].CreatedAt)
+		}
+	})
+
+	t.Run("add_issue_comment", func(t *testing.T) {
+		c, err := te.store.AddIssueComment(ctx, "cm-none", "bob", "fresh")
+		if err != nil {
+			t.Fatalf("AddIssueComment: %v", err)
+		}
+		if c.ID == "" || c.IssueID != "cm-none" {
+			t.Errorf("unexpected comment: %+v", c)
+		}
+		comments, err := te.store.GetIssueComments(ctx, "cm-none")
+		if err != nil {
+			t.Fatalf("GetIssueComments: %v", err)
+		}
+		if len(comments) != 1 || comments[0].ID != c.ID {
+			t.Errorf("got %v, want [%s]", comments, c.ID)
+		}
+	})
+
+	t.Run("missing_issue", func(t *testing.T) {
+		if _, err := te.store.AddIssueComment(ctx, "cm-missing", "bob", "x"); err == nil {
+			t.Error("expected error for nonexistent issue")
+		}
+	})
+
+	t.Run("comments_for_issues", func(t *testing.T) {
+		byIssue, err := te.store.GetCommentsForIssues(ctx, []string{"cm-a", "cm-b", "cm-missing"})
+		if err != nil {
+			t.Fatalf("GetCommentsForIssues: %v", err)
+		}
+		if len(byIssue["cm-a"]) != 2 || byIssue["cm-a"][0].Text != tricky {
+			t.Errorf("cm-a: got %v", byIssue["cm-a"])
+		}
+		if len(byIssue["cm-b"]) != 1 || byIssue["cm-b"][0].Text != "only" {
+			t.Errorf("cm-b: got %v", byIssue["cm-b"])
+		}
+		if _, ok := byIssue["cm-missing"]; ok {
+			t.Error("cm-missing: expected no entry")
+		}
+	})
+
+	t.Run("add_comment_event", func(t *testing.T) {
+		if err := te.store.AddComment(ctx, "cm-b", "carol", tricky); err != nil {
+			t.Fatalf("AddComment: %v", err)
+		}
+		var got string
+		te.queryScalar(t, ctx, "SELECT comment FROM events WHERE issue_id = ? AND event_type = ?",
+			[]any{"cm-b", string(types.EventCommented)}, &got)
+		if got != tricky {
+			t.Errorf("event comment: got %q, want %q", got, tricky)
+		}
+	})
+}
@@ -39,6 +39,12 @@ func (s *EmbeddedDoltStore) GetAllConfig(ctx context.Context) (map[string]string
 	return result, err
 }
 
+func (s *EmbeddedDoltStore) DeleteConfig(ctx context.Context, key string) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		return issueops.DeleteConfigInTx(ctx, tx, key)
+	})
+}
+
 func (s *EmbeddedDoltStore) GetMetadata(ctx context.Context, key string) (string, error) {
 	var value string
 	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
@@ -55,6 +61,22 @@ func (s *EmbeddedDoltStore) SetMetadata(ctx context.Context, key, value string)
 	})
 }
 
+func (s *EmbeddedDoltStore) GetAllMetadata(ctx context.Context) (map[string]string, error) {
+	var result map[string]string
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		result, err = issueops.GetAllMetadataInTx(ctx, tx)
+		return err
+	})
+	return result, err
+}
+
+func (s *EmbeddedDoltStore) DeleteMetadata(ctx context.Context, key string) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		return issueops.DeleteMetadataInTx(ctx, tx, key)
+	})
+}
+
 // GetInfraTypes returns the set of infrastructure types that should be routed
 // to the wisps table. Reads from DB config "types.infra", falls back to YAML,
 // then to hardcoded defaults (agent, rig, role, message).
@@ -0,0 +1,178 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestConfig(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "cf")
+	ctx := t.Context()
+
+	before, err := te.store.GetAllConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetAllConfig: %v", err)
+	}
+
+	want := map[string]string{
+		"cf.alpha": "1",
+		"cf.beta":  "2",
+		"cf.gamma": "3",
+	}
+	for k, v := range want {
+		if err := te.store.SetConfig(ctx, k, v); err != nil {
+			t.Fatalf("SetConfig(%s): %v", k, err)
+		}
+	}
+	if err := te.store.SetMetadata(ctx, "cf.meta", "hidden"); err != nil {
+		t.Fatalf("SetMetadata: %v", err)
+	}
+
+	all, err := te.store.GetAllConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetAllConfig: %v", err)
+	}
+	if len(all) != len(before)+len(want) {
+		t.Errorf("GetAllConfig: got %d keys, want %d", len(all), len(before)+len(want))
+	}
+	for k, v := range want {
+		if all[k] != v {
+			t.Errorf("GetAllConfig[%s]: got %q, want %q", k, all[k], v)
+		}
+	}
+	if _, ok := all["cf.meta"]; ok {
+		t.Error("GetAllConfig: metadata key cf.meta leaked into config")
+	}
+
+	if err := te.store.DeleteConfig(ctx, "cf.beta"); err != nil {
+		t.Fatalf("DeleteConfig: %v", err)
+	}
+	value, err := te.store.GetConfig(ctx, "cf.beta")
+	if err != nil {
+		t.Fatalf("GetConfig after delete: %v", err)
+	}
+	if value != "" {
+		t.Errorf("GetConfig after delete: got %q, want empty", value)
+	}
+	if value, _ := te.store.GetConfig(ctx, "cf.alpha"); value != "1" {
+		t.Errorf("DeleteConfig removed the wrong key: cf.alpha = %q", value)
+	}
+
+	if err := te.store.DeleteConfig(ctx, "cf.missing"); err != nil {
+		t.Errorf("DeleteConfig on a missing key: %v", err)
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "md")
+	ctx := t.Context()
+
+	before, err := te.store.GetAllMetadata(ctx)
+	if err != nil {
+		t.Fatalf("GetAllMetadata: %v", err)
+	}
+
+	// The same key in both namespaces must stay independent.
+	if err := te.store.SetConfig(ctx, "md.shared", "config-value"); err != nil {
+		t.Fatalf("SetConfig: %v", err)
+	}
+	if err := te.store.SetMetadata(ctx, "md.shared", "metadata-value"); err != nil {
+		t.Fatalf("SetMetadata: %v", err)
+	}
+	if err := te.store.SetMetadata(ctx, "md.only", "x"); err != nil {
+		t.Fatalf("SetMetadata: %v", err)
+	}
+
+	meta, err := te.store.GetAllMetadata(ctx)
+	if err != nil {
+		t.Fatalf("GetAllMetadata: %v", err)
+	}
+	if len(meta) != len(before)+2 {
+		t.Errorf("GetAllMetadata: got %d keys, want %d", len(meta), len(before)+2)
+	}
+	if meta["md.shared"] != "metadata-value" {
+		t.Errorf("GetAllMetadata[md.shared]: got %q, want metadata-value", meta["md.shared"])
+	}
+	config, err := te.store.GetAllConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetAllConfig: %v", err)
+	}
+	if config["md.shared"] != "config-value" {
+		t.Errorf("GetAllConfig[md.shared]: got %q, want config-value", config["md.shared"])
+	}
+	if _, ok := config["md.only"]; ok {
+		t.Error("GetAllConfig: metadata key md.only leaked into config")
+	}
+
+	if err := te.store.DeleteMetadata(ctx, "md.shared"); err != nil {
+		t.Fatalf("DeleteMetadata: %v", err)
+	}
+	if value, err := te.store.GetMetadata(ctx, "md.shared"); err != nil || value != "" {
+		t.Errorf("GetMetadata after delete: got %q, %v; want empty", value, err)
+	}
+	if value, _ := te.store.GetConfig(ctx, "md.shared"); value != "config-value" {
+		t.Errorf("DeleteMetadata touched config: md.shared = %q", value)
+	}
+	if value, _ := te.store.GetMetadata(ctx, "md.only"); value != "x" {
+		t.Errorf("DeleteMetadata removed the wrong key: md.only = %q", value)
+	}
+
+	if err := te.store.DeleteMetadata(ctx, "md.missing"); err != nil {
+		t.Errorf("DeleteMetadata on a missing key: %v", err)
+	}
+}
+
+func TestCustomStatusesAndTypes(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "cs")
+	ctx := t.Context()
+
+	if err := te.store.SetConfig(ctx, "status.custom", "review,qa"); err != nil {
+		t.Fatalf("SetConfig status.custom: %v", err)
+	}
+	if err := te.store.SetConfig(ctx, "types.custom", `["spike"]`); err != nil {
+		t.Fatalf("SetConfig types.custom: %v", err)
+	}
+
+	statuses, err := te.store.GetCustomStatuses(ctx)
+	if err != nil {
+		t.Fatalf("GetCustomStatuses: %v", err)
+	}
+	if got := strings.Join(statuses, ","); got != "review,qa" {
+		t.Errorf("GetCustomStatuses: got %q, want %q", got, "review,qa")
+	}
+	customTypes, err := te.store.GetCustomTypes(ctx)
+	if err != nil {
+		t.Fatalf("GetCustomTypes: %v", err)
+	}
+	if got := strings.Join(customTypes, ","); got != "spike" {
+		t.Errorf("GetCustomTypes: got %q, want %q", got, "spike")
+	}
+
+	review := types.Status("review")
+	issue := &types.Issue{ID: "cs-1", Title: "in review", Status: review, Priority: 2, IssueType: types.IssueType("spike")}
+	if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("CreateIssue with custom status and type: %v", err)
+	}
+	bad := &types.Issue{ID: "cs-2", Title: "bogus", Status: types.Status("bogus"), Priority: 2, IssueType: types.TypeTask}
+	if err := te.store.CreateIssue(ctx, bad, "tester"); err == nil {
+		t.Error("expected CreateIssue to reject a status outside the custom set")
+	}
+
+	results, err := te.store.SearchIssues(ctx, "", types.IssueFilter{Status: &review})
+	if err != nil {
+		t.Fatalf("SearchIssues: %v", err)
+	}
+	if len(results) != 1 || results[0].ID != "cs-1" {
+		t.Errorf("SearchIssues(status=review): got %d results, want cs-1", len(results))
+	}
+}
@@ -0,0 +1,16 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+)
+
+func (s *EmbeddedDoltStore) DeleteIssue(ctx context.Context, id string) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		return issueops.DeleteIssueInTx(ctx, tx, id)
+	})
+}
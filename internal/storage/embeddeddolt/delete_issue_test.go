@@ -0,0 +1,154 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestDeleteIssue(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("removes_related_rows", func(t *testing.T) {
+		te := newTestEnv(t, "dl")
+		ctx := t.Context()
+
+		for _, id := range []string{"dl-gone", "dl-up", "dl-down"} {
+			issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", id, err)
+			}
+		}
+		for _, dep := range []*types.Dependency{
+			{IssueID: "dl-gone", DependsOnID: "dl-up", Type: types.DepBlocks},
+			{IssueID: "dl-down", DependsOnID: "dl-gone", Type: types.DepBlocks},
+		} {
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s->%s: %v", dep.IssueID, dep.DependsOnID, err)
+			}
+		}
+		if err := te.store.AddLabel(ctx, "dl-gone", "backend", "tester"); err != nil {
+			t.Fatalf("AddLabel: %v", err)
+		}
+		if _, err := te.store.AddIssueComment(ctx, "dl-gone", "alice", "a comment"); err != nil {
+			t.Fatalf("AddIssueComment: %v", err)
+		}
+
+		if err := te.store.DeleteIssue(ctx, "dl-gone"); err != nil {
+			t.Fatalf("DeleteIssue: %v", err)
+		}
+
+		te.assertRowNotExists(t, ctx, "issues", "dl-gone")
+		te.assertRowExists(t, ctx, "issues", "dl-up")
+		te.assertRowExists(t, ctx, "issues", "dl-down")
+		te.assertLabelCount(t, ctx, "labels", "dl-gone", 0)
+
+		for _, table := range []string{"events", "comments"} {
+			var count int
+			te.queryScalar(t, ctx, "SELECT COUNT(*) FROM "+table+" WHERE issue_id = ?", []any{"dl-gone"}, &count)
+			if count != 0 {
+				t.Errorf("%s rows for deleted issue: got %d, want 0", table, count)
+			}
+		}
+		var count int
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE issue_id = ? OR depends_on_id = ?", []any{"dl-gone", "dl-gone"}, &count)
+		if count != 0 {
+			t.Errorf("dependency rows for deleted issue: got %d, want 0", count)
+		}
+
+		if _, err := te.store.GetIssue(ctx, "dl-gone"); !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("GetIssue after delete: expected ErrNotFound, got %v", err)
+		}
+		byLabel, err := te.store.SearchIssues(ctx, "", types.IssueFilter{Labels: []string{"backend"}})
+		if err != nil {
+			t.Fatalf("SearchIssues by label: %v", err)
+		}
+		if len(byLabel) != 0 {
+			t.Errorf("label search after delete: got %d issues, want 0", len(byLabel))
+		}
+	})
+
+	t.Run("wisp", func(t *testing.T) {
+		te := newTestEnv(t, "dw")
+		ctx := t.Context()
+
+		wisp := &types.Issue{ID: "dw-wisp1", Title: "wisp", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true}
+		if err := te.store.CreateIssue(ctx, wisp, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+		if err := te.store.AddLabel(ctx, "dw-wisp1", "scratch", "tester"); err != nil {
+			t.Fatalf("AddLabel: %v", err)
+		}
+
+		if err := te.store.DeleteIssue(ctx, "dw-wisp1"); err != nil {
+			t.Fatalf("DeleteIssue: %v", err)
+		}
+
+		te.assertRowNotExists(t, ctx, "wisps", "dw-wisp1")
+		te.assertLabelCount(t, ctx, "wisp_labels", "dw-wisp1", 0)
+	})
+
+	t.Run("cross_table_edges", func(t *testing.T) {
+		te := newTestEnv(t, "dx")
+		ctx := t.Context()
+
+		for _, issue := range []*types.Issue{
+			{ID: "dx-perm", Title: "permanent", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+			{ID: "dx-keep", Title: "permanent dependent", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+			{ID: "dx-wisp1", Title: "wisp dependent", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true},
+			{ID: "dx-wisp2", Title: "wisp target", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true},
+		} {
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+			}
+		}
+		for _, dep := range []*types.Dependency{
+			{IssueID: "dx-wisp1", DependsOnID: "dx-perm", Type: types.DepBlocks},
+			{IssueID: "dx-keep", DependsOnID: "dx-wisp2", Type: types.DepBlocks},
+		} {
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s->%s: %v", dep.IssueID, dep.DependsOnID, err)
+			}
+		}
+
+		// A wisp that depends on a deleted permanent issue loses the edge.
+		if err := te.store.DeleteIssue(ctx, "dx-perm"); err != nil {
+			t.Fatalf("DeleteIssue dx-perm: %v", err)
+		}
+		var count int
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM wisp_dependencies WHERE depends_on_id = ?", []any{"dx-perm"}, &count)
+		if count != 0 {
+			t.Errorf("wisp_dependencies rows pointing at deleted issue: got %d, want 0", count)
+		}
+		te.assertRowExists(t, ctx, "wisps", "dx-wisp1")
+		if blocked, _, err := te.store.IsBlocked(ctx, "dx-wisp1"); err != nil {
+			t.Fatalf("IsBlocked: %v", err)
+		} else if blocked {
+			t.Error("dx-wisp1 still blocked by deleted issue")
+		}
+
+		// And the reverse: a permanent issue that depends on a deleted wisp.
+		if err := te.store.DeleteIssue(ctx, "dx-wisp2"); err != nil {
+			t.Fatalf("DeleteIssue dx-wisp2: %v", err)
+		}
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE depends_on_id = ?", []any{"dx-wisp2"}, &count)
+		if count != 0 {
+			t.Errorf("dependencies rows pointing at deleted wisp: got %d, want 0", count)
+		}
+		te.assertRowExists(t, ctx, "issues", "dx-keep")
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		te := newTestEnv(t, "dn")
+		ctx := t.Context()
+
+		err := te.store.DeleteIssue(ctx, "dn-missing")
+		if !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
@@ -3,9 +3,11 @@
 package embeddeddolt_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -59,8 +61,63 @@ func TestAddDependency(t *testing.T) {
 		if err == nil {
 			t.Fatal("expected cycle detection error")
 		}
-		if !strings.Contains(err.Error(), "cycle") {
-			t.Errorf("expected cycle error, got: %v", err)
+		if !errors.Is(err, storage.ErrWouldCreateCycle) {
+			t.Errorf("expected ErrWouldCreateCycle, got: %v", err)
+		}
+		if !strings.Contains(err.Error(), "cy-b -> cy-a -> cy-b") {
+			t.Errorf("expected cycle path in error, got: %v", err)
+		}
+	})
+
+	t.Run("transitive_cycle_detection", func(t *testing.T) {
+		te := newTestEnv(t, "tr")
+		ctx := t.Context()
+
+		for _, id := range []string{"tr-a", "tr-b", "tr-c"} {
+			issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", id, err)
+			}
+		}
+		for _, dep := range []*types.Dependency{
+			{IssueID: "tr-a", DependsOnID: "tr-b", Type: types.DepBlocks},
+			{IssueID: "tr-b", DependsOnID: "tr-c", Type: types.DepBlocks},
+		} {
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s->%s: %v", dep.IssueID, dep.DependsOnID, err)
+			}
+		}
+
+		// C blocks on A would close A -> B -> C -> A.
+		err := te.store.AddDependency(ctx, &types.Dependency{IssueID: "tr-c", DependsOnID: "tr-a", Type: types.DepBlocks}, "tester")
+		if !errors.Is(err, storage.ErrWouldCreateCycle) {
+			t.Fatalf("expected ErrWouldCreateCycle, got: %v", err)
+		}
+		if !strings.Contains(err.Error(), "tr-c -> tr-a -> tr-b -> tr-c") {
+			t.Errorf("expected cycle path in error, got: %v", err)
+		}
+		var count int
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE issue_id = ? AND depends_on_id = ?", []any{"tr-c", "tr-a"}, &count)
+		if count != 0 {
+			t.Errorf("expected rejected edge not to be written, found %d", count)
+		}
+	})
+
+	t.Run("non_blocking_type_allows_loop", func(t *testing.T) {
+		te := newTestEnv(t, "nb")
+		ctx := t.Context()
+
+		for _, id := range []string{"nb-a", "nb-b"} {
+			issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", id, err)
+			}
+		}
+		if err := te.store.AddDependency(ctx, &types.Dependency{IssueID: "nb-a", DependsOnID: "nb-b", Type: types.DepBlocks}, "tester"); err != nil {
+			t.Fatalf("AddDependency blocks: %v", err)
+		}
+		if err := te.store.AddDependency(ctx, &types.Dependency{IssueID: "nb-b", DependsOnID: "nb-a", Type: types.DepRelated}, "tester"); err != nil {
+			t.Fatalf("AddDependency related: %v", err)
 		}
 	})
 
@@ -113,6 +170,13 @@ func TestAddDependency(t *testing.T) {
 		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
 			t.Fatalf("AddDependency (second): %v", err)
 		}
+
+		var count int
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE issue_id = ? AND depends_on_id = ?", []any{"id-a", "id-b"}, &count)
+		if count != 1 {
+			t.Errorf("expected exactly 1 edge after re-adding, got %d", count)
+		}
+		te.assertEventCount(t, ctx, "events", "id-a", string(types.EventDependencyAdded), 1)
 	})
 
 	t.Run("type_conflict", func(t *testing.T) {
@@ -282,3 +346,326 @@ func TestAddDependency(t *testing.T) {
 		}
 	})
 }
+
+func TestRemoveDependency(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("removes_exact_edge_with_shared_target", func(t *testing.T) {
+		te := newTestEnv(t, "rd")
+		ctx := t.Context()
+
+		for _, id := range []string{"rd-target", "rd-a", "rd-b"} {
+			issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", id, err)
+			}
+		}
+		for _, id := range []string{"rd-a", "rd-b"} {
+			dep := &types.Dependency{IssueID: id, DependsOnID: "rd-target", Type: types.DepBlocks}
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s: %v", id, err)
+			}
+		}
+
+		// Remove the older edge; the newer edge to the same target must survive.
+		if err := te.store.RemoveDependency(ctx, "rd-a", "rd-target", "remover"); err != nil {
+			t.Fatalf("RemoveDependency: %v", err)
+		}
+
+		var count int
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE issue_id = ? AND depends_on_id = ?", []any{"rd-a", "rd-target"}, &count)
+		if count != 0 {
+			t.Errorf("expected rd-a edge removed, found %d", count)
+		}
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE issue_id = ? AND depends_on_id = ?", []any{"rd-b", "rd-target"}, &count)
+		if count != 1 {
+			t.Errorf("expected rd-b edge kept, found %d", count)
+		}
+		te.assertEventCount(t, ctx, "events", "rd-a", string(types.EventDependencyRemoved), 1)
+		te.assertEventCount(t, ctx, "events", "rd-b", string(types.EventDependencyRemoved), 0)
+	})
+
+	t.Run("missing_edge_is_noop", func(t *testing.T) {
+		te := newTestEnv(t, "rn")
+		ctx := t.Context()
+
+		issue := &types.Issue{ID: "rn-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+		if err := te.store.RemoveDependency(ctx, "rn-a", "rn-missing", "remover"); err != nil {
+			t.Fatalf("RemoveDependency: %v", err)
+		}
+		te.assertEventCount(t, ctx, "events", "rn-a", string(types.EventDependencyRemoved), 0)
+	})
+}
+
+func TestGetDependencies(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("returns_targets_by_priority", func(t *testing.T) {
+		te := newTestEnv(t, "gp")
+		ctx := t.Context()
+
+		src := &types.Issue{ID: "gp-src", Title: "Source", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		low := &types.Issue{ID: "gp-low", Title: "Low", Status: types.StatusOpen, Priority: 3, IssueType: types.TypeTask}
+		high := &types.Issue{ID: "gp-high", Title: "High", Status: types.StatusOpen, Priority: 0, IssueType: types.TypeTask}
+		for _, issue := range []*types.Issue{src, low, high} {
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+			}
+		}
+		for _, target := range []string{"gp-low", "gp-high", "external:other:gp-x"} {
+			dep := &types.Dependency{IssueID: "gp-src", DependsOnID: target, Type: types.DepBlocks}
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s: %v", target, err)
+			}
+		}
+
+		// The external reference has no issue row and is omitted.
+		got, err := te.store.GetDependencies(ctx, "gp-src")
+		if err != nil {
+			t.Fatalf("GetDependencies: %v", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("expected 2 dependencies, got %d", len(got))
+		}
+		if got[0].ID != "gp-high" || got[1].ID != "gp-low" {
+			t.Errorf("expected [gp-high gp-low], got [%s %s]", got[0].ID, got[1].ID)
+		}
+	})
+
+	t.Run("no_dependencies", func(t *testing.T) {
+		te := newTestEnv(t, "gn")
+		ctx := t.Context()
+
+		a := &types.Issue{ID: "gn-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, a, "tester"); err != nil {
+			t.Fatalf("CreateIssue A: %v", err)
+		}
+
+		got, err := te.store.GetDependencies(ctx, "gn-a")
+		if err != nil {
+			t.Fatalf("GetDependencies: %v", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("expected no dependencies, got %d", len(got))
+		}
+	})
+}
+
+func TestGetDependents(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("returns_dependents_by_priority", func(t *testing.T) {
+		te := newTestEnv(t, "gd")
+		ctx := t.Context()
+
+		target := &types.Issue{ID: "gd-target", Title: "Target", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		low := &types.Issue{ID: "gd-low", Title: "Low", Status: types.StatusOpen, Priority: 3, IssueType: types.TypeTask}
+		high := &types.Issue{ID: "gd-high", Title: "High", Status: types.StatusOpen, Priority: 0, IssueType: types.TypeTask}
+		for _, issue := range []*types.Issue{target, low, high} {
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+			}
+		}
+		for _, id := range []string{"gd-low", "gd-high"} {
+			dep := &types.Dependency{IssueID: id, DependsOnID: "gd-target", Type: types.DepBlocks}
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s: %v", id, err)
+			}
+		}
+
+		got, err := te.store.GetDependents(ctx, "gd-target")
+		if err != nil {
+			t.Fatalf("GetDependents: %v", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("expected 2 dependents, got %d", len(got))
+		}
+		if got[0].ID != "gd-high" || got[1].ID != "gd-low" {
+			t.Errorf("expected [gd-high gd-low], got [%s %s]", got[0].ID, got[1].ID)
+		}
+	})
+
+	t.Run("no_dependents", func(t *testing.T) {
+		te := newTestEnv(t, "nd")
+		ctx := t.Context()
+
+		a := &types.Issue{ID: "nd-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, a, "tester"); err != nil {
+			t.Fatalf("CreateIssue A: %v", err)
+		}
+
+		got, err := te.store.GetDependents(ctx, "nd-a")
+		if err != nil {
+			t.Fatalf("GetDependents: %v", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("expected no dependents, got %d", len(got))
+		}
+	})
+}
+
+func TestDetectCycles(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("no_cycles", func(t *testing.T) {
+		te := newTestEnv(t, "nc")
+		ctx := t.Context()
+
+		a := &types.Issue{ID: "nc-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		b := &types.Issue{ID: "nc-b", Title: "B", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		for _, issue := range []*types.Issue{a, b} {
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+			}
+		}
+		dep := &types.Dependency{IssueID: "nc-a", DependsOnID: "nc-b", Type: types.DepBlocks}
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency: %v", err)
+		}
+
+		cycles, err := te.store.DetectCycles(ctx)
+		if err != nil {
+			t.Fatalf("DetectCycles: %v", err)
+		}
+		if len(cycles) != 0 {
+			t.Errorf("expected no cycles, got %d", len(cycles))
+		}
+	})
+
+	t.Run("three_node_cycle", func(t *testing.T) {
+		te := newTestEnv(t, "dc")
+		ctx := t.Context()
+
+		for _, id := range []string{"dc-a", "dc-b", "dc-c"} {
+			issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", id, err)
+			}
+		}
+		// AddDependency rejects cycles, so write the closing edge directly.
+		for _, edge := range [][2]string{{"dc-a", "dc-b"}, {"dc-b", "dc-c"}, {"dc-c", "dc-a"}} {
+			te.exec(t, ctx,
+				"INSERT INTO dependencies (issue_id, depends_on_id, type, created_by) VALUES (?, ?, 'blocks', 'tester')",
+				edge[0], edge[1])
+		}
+
+		cycles, err := te.store.DetectCycles(ctx)
+		if err != nil {
+			t.Fatalf("DetectCycles: %v", err)
+		}
+		if len(cycles) != 1 {
+			t.Fatalf("expected 1 cycle, got %d", len(cycles))
+		}
+		var got []string
+		for _, issue := range cycles[0] {
+			got = append(got, issue.ID)
+		}
+		if strings.Join(got, ",") != "dc-a,dc-b,dc-c" {
+			t.Errorf("cycle path: got %v, want [dc-a dc-b dc-c]", got)
+		}
+	})
+}
+
+func TestGetDependencyCounts(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "dn")
+	ctx := t.Context()
+
+	for _, id := range []string{"dn-a", "dn-b", "dn-c", "dn-lone"} {
+		issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", id, err)
+		}
+	}
+	for _, dep := range []*types.Dependency{
+		{IssueID: "dn-a", DependsOnID: "dn-c", Type: types.DepBlocks},
+		{IssueID: "dn-b", DependsOnID: "dn-c", Type: types.DepBlocks},
+		{IssueID: "dn-a", DependsOnID: "dn-b", Type: types.DepBlocks},
+	} {
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency %s -> %s: %v", dep.IssueID, dep.DependsOnID, err)
+		}
+	}
+
+	counts, err := te.store.GetDependencyCounts(ctx, []string{"dn-a", "dn-b", "dn-c", "dn-lone", "dn-missing"})
+	if err != nil {
+		t.Fatalf("GetDependencyCounts: %v", err)
+	}
+	want := map[string]types.DependencyCounts{
+		"dn-a":       {DependencyCount: 2, DependentCount: 0},
+		"dn-b":       {DependencyCount: 1, DependentCount: 1},
+		"dn-c":       {DependencyCount: 0, DependentCount: 2},
+		"dn-lone":    {},
+		"dn-missing": {},
+	}
+	for id, w := range want {
+		got, ok := counts[id]
+		if !ok {
+			t.Errorf("%s: missing from result", id)
+			continue
+		}
+		if *got != w {
+			t.Errorf("%s: got %+v, want %+v", id, *got, w)
+		}
+	}
+}
+
+func TestGetDependenciesWithMetadata(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "dm")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "dm-epic", Title: "epic", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeEpic},
+		{ID: "dm-task", Title: "task", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "dm-done", Title: "done", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	for _, dep := range []*types.Dependency{
+		{IssueID: "dm-task", DependsOnID: "dm-epic", Type: types.DepParentChild},
+		{IssueID: "dm-task", DependsOnID: "dm-done", Type: types.DepBlocks},
+	} {
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency %s->%s: %v", dep.IssueID, dep.DependsOnID, err)
+		}
+	}
+
+	deps, err := te.store.GetDependenciesWithMetadata(ctx, "dm-task")
+	if err != nil {
+		t.Fatalf("GetDependenciesWithMetadata: %v", err)
+	}
+	gotTypes := make(map[string]types.DependencyType, len(deps))
+	for _, d := range deps {
+		gotTypes[d.ID] = d.DependencyType
+		if d.ID == "dm-done" && d.Status != types.StatusClosed {
+			t.Errorf("dm-done: got status %s, want closed", d.Status)
+		}
+	}
+	if len(gotTypes) != 2 || gotTypes["dm-epic"] != types.DepParentChild || gotTypes["dm-done"] != types.DepBlocks {
+		t.Errorf("GetDependenciesWithMetadata(dm-task): got %v", gotTypes)
+	}
+
+	dependents, err := te.store.GetDependentsWithMetadata(ctx, "dm-epic")
+	if err != nil {
+		t.Fatalf("GetDependentsWithMetadata: %v", err)
+	}
+	if len(dependents) != 1 || dependents[0].ID != "dm-task" || dependents[0].DependencyType != types.DepParentChild {
+		t.Errorf("GetDependentsWithMetadata(dm-epic): got %+v, want dm-task (parent-child)", dependents)
+	}
+
+	none, err := te.store.GetDependenciesWithMetadata(ctx, "dm-epic")
+	if err != nil {
+		t.Fatalf("GetDependenciesWithMetadata(dm-epic): %v", err)
+	}
+	if len(none) != 0 {
+		t.Errorf("GetDependenciesWithMetadata(dm-epic): got %d, want 0", len(none))
+	}
+}
@@ -0,0 +1,121 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestPropagateClose(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "pc")
+	ctx := t.Context()
+
+	create := func(t *testing.T, id string, issueType types.IssueType, parentIDs ...string) {
+		t.Helper()
+		issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: issueType}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", id, err)
+		}
+		for _, parentID := range parentIDs {
+			dep := &types.Dependency{IssueID: id, DependsOnID: parentID, Type: types.DepParentChild}
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s -> %s: %v", id, parentID, err)
+			}
+		}
+	}
+	closeAndPropagate := func(t *testing.T, id string) []string {
+		t.Helper()
+		if err := te.store.CloseIssue(ctx, id, "done", "tester", "sess"); err != nil {
+			t.Fatalf("CloseIssue %s: %v", id, err)
+		}
+		closed, err := storage.PropagateClose(ctx, te.store, id, "tester", "sess")
+		if err != nil {
+			t.Fatalf("PropagateClose %s: %v", id, err)
+		}
+		return closed
+	}
+	assertStatus := func(t *testing.T, id string, want types.Status) {
+		t.Helper()
+		got, err := te.store.GetIssue(ctx, id)
+		if err != nil {
+			t.Fatalf("GetIssue %s: %v", id, err)
+		}
+		if got.Status != want {
+			t.Errorf("%s status: got %q, want %q", id, got.Status, want)
+		}
+	}
+
+	t.Run("single_level", func(t *testing.T) {
+		create(t, "pc-e1", types.TypeEpic)
+		create(t, "pc-e1.1", types.TypeTask, "pc-e1")
+		create(t, "pc-e1.2", types.TypeTask, "pc-e1")
+
+		if closed := closeAndPropagate(t, "pc-e1.1"); len(closed) != 0 {
+			t.Errorf("closed %v while pc-e1.2 is open, want none", closed)
+		}
+		assertStatus(t, "pc-e1", types.StatusOpen)
+
+		if closed := closeAndPropagate(t, "pc-e1.2"); !slices.Equal(closed, []string{"pc-e1"}) {
+			t.Errorf("closed: got %v, want [pc-e1]", closed)
+		}
+		assertStatus(t, "pc-e1", types.StatusClosed)
+		got, err := te.store.GetIssue(ctx, "pc-e1")
+		if err != nil {
+			t.Fatalf("GetIssue: %v", err)
+		}
+		if got.CloseReason != "All children completed" {
+			t.Errorf("CloseReason: got %q, want %q", got.CloseReason, "All children completed")
+		}
+
+		closed, err := storage.PropagateClose(ctx, te.store, "pc-e1.2", "tester", "sess")
+		if err != nil {
+			t.Fatalf("PropagateClose rerun: %v", err)
+		}
+		if len(closed) != 0 {
+			t.Errorf("rerun closed %v, want none", closed)
+		}
+		te.assertEventCount(t, ctx, "events", "pc-e1", string(types.EventClosed), 1)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		create(t, "pc-outer", types.TypeEpic)
+		create(t, "pc-inner", types.TypeEpic, "pc-outer")
+		create(t, "pc-leaf", types.TypeTask, "pc-inner")
+
+		if closed := closeAndPropagate(t, "pc-leaf"); !slices.Equal(closed, []string{"pc-inner", "pc-outer"}) {
+			t.Errorf("closed: got %v, want [pc-inner pc-outer]", closed)
+		}
+		assertStatus(t, "pc-inner", types.StatusClosed)
+		assertStatus(t, "pc-outer", types.StatusClosed)
+	})
+
+	t.Run("multiple_parents", func(t *testing.T) {
+		create(t, "pc-ma", types.TypeEpic)
+		create(t, "pc-mb", types.TypeEpic)
+		create(t, "pc-shared", types.TypeTask, "pc-ma", "pc-mb")
+
+		closed := closeAndPropagate(t, "pc-shared")
+		slices.Sort(closed)
+		if !slices.Equal(closed, []string{"pc-ma", "pc-mb"}) {
+			t.Errorf("closed: got %v, want [pc-ma pc-mb]", closed)
+		}
+		assertStatus(t, "pc-ma", types.StatusClosed)
+		assertStatus(t, "pc-mb", types.StatusClosed)
+	})
+
+	t.Run("non_epic_parent", func(t *testing.T) {
+		create(t, "pc-feat", types.TypeFeature)
+		create(t, "pc-feat.1", types.TypeTask, "pc-feat")
+
+		if closed := closeAndPropagate(t, "pc-feat.1"); len(closed) != 0 {
+			t.Errorf("closed %v for non-epic parent, want none", closed)
+		}
+		assertStatus(t, "pc-feat", types.StatusOpen)
+	})
+}
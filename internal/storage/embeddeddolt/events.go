@@ -0,0 +1,32 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func (s *EmbeddedDoltStore) GetEvents(ctx context.Context, issueID string, limit int) ([]*types.Event, error) {
+	var events []*types.Event
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		events, err = issueops.GetEventsInTx(ctx, tx, issueID, limit)
+		return err
+	})
+	return events, err
+}
+
+func (s *EmbeddedDoltStore) GetAllEventsSince(ctx context.Context, since time.Time) ([]*types.Event, error) {
+	var events []*types.Event
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		events, err = issueops.GetAllEventsSinceInTx(ctx, tx, since)
+		return err
+	})
+	return events, err
+}
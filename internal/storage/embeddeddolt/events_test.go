@@ -0,0 +1,235 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestGetAllEventsSince(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "ev")
+	ctx := t.Context()
+
+	for _, id := range []string{"ev-a", "ev-b"} {
+		issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", id, err)
+		}
+	}
+
+	// Pin event timestamps so the ordering and cutoff are deterministic.
+	base := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	te.exec(t, ctx, "DELETE FROM events")
+	for i, e := range []struct {
+		issueID string
+		at      time.Time
+	}{
+		{"ev-b", base.Add(2 * time.Hour)},
+		{"ev-a", base},
+		{"ev-a", base.Add(time.Hour)},
+	} {
+		te.exec(t, ctx, `INSERT INTO events (issue_id, event_type, actor, comment, created_at) VALUES (?, ?, ?, ?, ?)`,
+			e.issueID, string(types.EventCommented), "tester", string(rune('a'+i)), e.at)
+	}
+
+	t.Run("ordered_ascending", func(t *testing.T) {
+		events, err := te.store.GetAllEventsSince(ctx, base.Add(-time.Minute))
+		if err != nil {
+			t.Fatalf("GetAllEventsSince: %v", err)
+		}
+		if len(events) != 3 {
+			t.Fatalf("got %d events, want 3", len(events))
+		}
+		for i := 1; i < len(events); i++ {
+			if events[i].CreatedAt.Before(events[i-1].CreatedAt) {
+				t.Errorf("events out of order at %d: %v before %v", i, events[i].CreatedAt, events[i-1].CreatedAt)
+			}
+		}
+		if events[2].IssueID != "ev-b" {
+			t.Errorf("last event: got %s, want ev-b", events[2].IssueID)
+		}
+	})
+
+	t.Run("resume_from_checkpoint", func(t *testing.T) {
+		events, err := te.store.GetAllEventsSince(ctx, base)
+		if err != nil {
+			t.Fatalf("GetAllEventsSince: %v", err)
+		}
+		if len(events) != 2 {
+			t.Fatalf("got %d events, want 2 (strictly after checkpoint)", len(events))
+		}
+		if !events[0].CreatedAt.Equal(base.Add(time.Hour)) {
+			t.Errorf("first event after checkpoint: got %v", events[0].CreatedAt)
+		}
+	})
+}
+
+func TestGetEvents(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "ge")
+	ctx := t.Context()
+
+	issue := &types.Issue{ID: "ge-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := te.store.CreateIssue(ctx, issue, "alice"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	if err := te.store.UpdateIssue(ctx, "ge-a", map[string]interface{}{"priority": 1}, "bob"); err != nil {
+		t.Fatalf("UpdateIssue: %v", err)
+	}
+	if err := te.store.CloseIssue(ctx, "ge-a", "done", "carol", ""); err != nil {
+		t.Fatalf("CloseIssue: %v", err)
+	}
+
+	// created_at has one-second resolution; pin it so the order is deterministic.
+	base := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i, eventType := range []types.EventType{types.EventCreated, types.EventUpdated, types.EventClosed} {
+		te.exec(t, ctx, "UPDATE events SET created_at = ? WHERE issue_id = ? AND event_type = ?",
+			base.Add(time.Duration(i)*time.Minute), "ge-a", string(eventType))
+	}
+
+	t.Run("newest_first", func(t *testing.T) {
+		events, err := te.store.GetEvents(ctx, "ge-a", 0)
+		if err != nil {
+			t.Fatalf("GetEvents: %v", err)
+		}
+		want := []struct {
+			eventType types.EventType
+			actor     string
+		}{
+			{types.EventClosed, "carol"},
+			{types.EventUpdated, "bob"},
+			{types.EventCreated, "alice"},
+		}
+		if len(events) != len(want) {
+			t.Fatalf("got %d events, want %d", len(events), len(want))
+		}
+		for i, w := range want {
+			if events[i].EventType != w.eventType || events[i].Actor != w.actor {
+				t.Errorf("event %d: got %s by %s, want %s by %s", i, events[i].EventType, events[i].Actor, w.eventType, w.actor)
+			}
+		}
+		if events[0].NewValue == nil || *events[0].NewValue != "done" {
+			t.Errorf("closed event new_value: got %v, want done", events[0].NewValue)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		events, err := te.store.GetEvents(ctx, "ge-a", 1)
+		if err != nil {
+			t.Fatalf("GetEvents: %v", err)
+		}
+		if len(events) != 1 || events[0].EventType != types.EventClosed {
+			t.Errorf("got %d events, want only the closed event", len(events))
+		}
+	})
+
+	t.Run("wisp", func(t *testing.T) {
+		wisp := &types.Issue{ID: "ge-wisp1", Title: "wisp", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true}
+		if err := te.store.CreateIssue(ctx, wisp, "dave"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+		events, err := te.store.GetEvents(ctx, "ge-wisp1", 0)
+		if err != nil {
+			t.Fatalf("GetEvents: %v", err)
+		}
+		if len(events) != 1 || events[0].EventType != types.EventCreated || events[0].Actor != "dave" {
+			t.Errorf("got %d events, want one created event by dave", len(events))
+		}
+	})
+
+	t.Run("unknown_issue", func(t *testing.T) {
+		events, err := te.store.GetEvents(ctx, "ge-missing", 0)
+		if err != nil {
+			t.Fatalf("GetEvents: %v", err)
+		}
+		if len(events) != 0 {
+			t.Errorf("got %d events, want 0", len(events))
+		}
+	})
+}
+
+func TestMutationsRecordEvents(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "me")
+	ctx := t.Context()
+
+	for _, id := range []string{"me-a", "me-b"} {
+		issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "creator"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", id, err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func() error
+		eventType types.EventType
+		actor     string
+	}{
+		{"create", func() error { return nil }, types.EventCreated, "creator"},
+		{"update", func() error {
+			return te.store.UpdateIssue(ctx, "me-a", map[string]interface{}{"title": "renamed"}, "updater")
+		}, types.EventUpdated, "updater"},
+		{"add_dependency", func() error {
+			return te.store.AddDependency(ctx, &types.Dependency{IssueID: "me-a", DependsOnID: "me-b", Type: types.DepBlocks}, "linker")
+		}, types.EventDependencyAdded, "linker"},
+		{"add_label", func() error { return te.store.AddLabel(ctx, "me-a", "backend", "labeler") }, types.EventLabelAdded, "labeler"},
+		{"remove_label", func() error { return te.store.RemoveLabel(ctx, "me-a", "backend", "unlabeler") }, types.EventLabelRemoved, "unlabeler"},
+		{"close", func() error { return te.store.CloseIssue(ctx, "me-a", "done", "closer", "") }, types.EventClosed, "closer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.mutate(); err != nil {
+				t.Fatalf("mutation: %v", err)
+			}
+			events, err := te.store.GetEvents(ctx, "me-a", 0)
+			if err != nil {
+				t.Fatalf("GetEvents: %v", err)
+			}
+			var found int
+			for _, e := range events {
+				if e.EventType == tt.eventType {
+					found++
+					if e.Actor != tt.actor {
+						t.Errorf("%s actor: got %q, want %q", tt.eventType, e.Actor, tt.actor)
+					}
+				}
+			}
+			if found != 1 {
+				t.Errorf("%s events: got %d, want 1", tt.eventType, found)
+			}
+		})
+	}
+
+	t.Run("idempotent_dependency_records_once", func(t *testing.T) {
+		if err := te.store.AddDependency(ctx, &types.Dependency{IssueID: "me-a", DependsOnID: "me-b", Type: types.DepBlocks}, "linker"); err != nil {
+			t.Fatalf("AddDependency: %v", err)
+		}
+		te.assertEventCount(t, ctx, "events", "me-a", string(types.EventDependencyAdded), 1)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		all, err := te.store.GetEvents(ctx, "me-a", 0)
+		if err != nil {
+			t.Fatalf("GetEvents: %v", err)
+		}
+		if len(all) != len(tests) {
+			t.Fatalf("got %d events, want %d", len(all), len(tests))
+		}
+		limited, err := te.store.GetEvents(ctx, "me-a", 3)
+		if err != nil {
+			t.Fatalf("GetEvents: %v", err)
+		}
+		if len(limited) != 3 {
+			t.Errorf("got %d events with limit 3, want 3", len(limited))
+		}
+	})
+}
@@ -19,3 +19,23 @@ func (s *EmbeddedDoltStore) GetIssue(ctx context.Context, id string) (*types.Iss
 	})
 	return issue, err
 }
+
+func (s *EmbeddedDoltStore) GetIssueByExternalRef(ctx context.Context, externalRef string) (*types.Issue, error) {
+	var issue *types.Issue
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		issue, err = issueops.GetIssueByExternalRefInTx(ctx, tx, externalRef)
+		return err
+	})
+	return issue, err
+}
+
+func (s *EmbeddedDoltStore) GetIssuesByIDs(ctx context.Context, ids []string) ([]*types.Issue, error) {
+	var issues []*types.Issue
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		issues, err = issueops.GetIssuesByIDsInTx(ctx, tx, ids)
+		return err
+	})
+	return issues, err
+}
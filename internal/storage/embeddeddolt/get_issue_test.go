@@ -4,6 +4,7 @@ package embeddeddolt_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/steveyegge/beads/internal/storage"
@@ -105,6 +106,82 @@ func TestGetIssue(t *testing.T) {
 	})
 }
 
+func TestGetIssueByExternalRef(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "er")
+	ctx := t.Context()
+
+	ref := "gh-42"
+	for _, issue := range []*types.Issue{
+		{ID: "er-linked", Title: "linked", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, ExternalRef: &ref},
+		{ID: "er-plain", Title: "plain", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+
+	got, err := te.store.GetIssueByExternalRef(ctx, "gh-42")
+	if err != nil {
+		t.Fatalf("GetIssueByExternalRef: %v", err)
+	}
+	if got.ID != "er-linked" {
+		t.Errorf("GetIssueByExternalRef: got %s, want er-linked", got.ID)
+	}
+
+	_, err = te.store.GetIssueByExternalRef(ctx, "gh-missing")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetIssueByExternalRef(missing): got %v, want ErrNotFound", err)
+	}
+}
+
+func TestGetIssuesByIDs(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "gb")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "gb-a", Title: "a", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "gb-b", Title: "b", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "gb-wisp", Title: "wisp", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	if err := te.store.AddLabel(ctx, "gb-a", "ui", "tester"); err != nil {
+		t.Fatalf("AddLabel: %v", err)
+	}
+
+	issues, err := te.store.GetIssuesByIDs(ctx, []string{"gb-a", "gb-b", "gb-wisp", "gb-missing"})
+	if err != nil {
+		t.Fatalf("GetIssuesByIDs: %v", err)
+	}
+	byID := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+	if len(byID) != 3 {
+		t.Fatalf("got %d issues, want 3 (gb-a, gb-b, gb-wisp)", len(byID))
+	}
+	if _, ok := byID["gb-missing"]; ok {
+		t.Error("gb-missing: expected nonexistent issue to be skipped")
+	}
+	if got := strings.Join(byID["gb-a"].Labels, ","); got != "ui" {
+		t.Errorf("gb-a labels: got %q, want %q", got, "ui")
+	}
+
+	empty, err := te.store.GetIssuesByIDs(ctx, nil)
+	if err != nil {
+		t.Fatalf("GetIssuesByIDs(nil): %v", err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("GetIssuesByIDs(nil): got %d issues, want 0", len(empty))
+	}
+}
+
 func TestGetLabels(t *testing.T) {
 	skipUnlessEmbeddedDolt(t)
 
@@ -171,6 +248,44 @@ func TestGetLabels(t *testing.T) {
 	})
 }
 
+func TestGetLabelsForIssues(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "lf")
+	ctx := t.Context()
+
+	for _, id := range []string{"lf-a", "lf-b", "lf-none"} {
+		issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", id, err)
+		}
+	}
+	for _, l := range []struct{ id, label string }{
+		{"lf-a", "ui"}, {"lf-a", "bug"}, {"lf-b", "backend"},
+	} {
+		if err := te.store.AddLabel(ctx, l.id, l.label, "tester"); err != nil {
+			t.Fatalf("AddLabel(%s, %s): %v", l.id, l.label, err)
+		}
+	}
+
+	labelMap, err := te.store.GetLabelsForIssues(ctx, []string{"lf-a", "lf-b", "lf-none", "lf-missing"})
+	if err != nil {
+		t.Fatalf("GetLabelsForIssues: %v", err)
+	}
+	if got := strings.Join(labelMap["lf-a"], ","); got != "bug,ui" {
+		t.Errorf("lf-a: got %q, want %q", got, "bug,ui")
+	}
+	if got := strings.Join(labelMap["lf-b"], ","); got != "backend" {
+		t.Errorf("lf-b: got %q, want %q", got, "backend")
+	}
+	if len(labelMap["lf-none"]) != 0 {
+		t.Errorf("lf-none: expected no labels, got %v", labelMap["lf-none"])
+	}
+	if _, ok := labelMap["lf-missing"]; ok {
+		t.Error("lf-missing: expected no entry for nonexistent issue")
+	}
+}
+
 func TestAddLabel(t *testing.T) {
 	skipUnlessEmbeddedDolt(t)
 
@@ -208,3 +323,53 @@ func TestAddLabel(t *testing.T) {
 		}
 	})
 }
+
+func TestRemoveLabel(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "rl")
+	ctx := t.Context()
+
+	issue := &types.Issue{ID: "rl-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	for _, l := range []string{"keep", "drop"} {
+		if err := te.store.AddLabel(ctx, "rl-a", l, "tester"); err != nil {
+			t.Fatalf("AddLabel(%s): %v", l, err)
+		}
+	}
+	if err := te.store.RemoveLabel(ctx, "rl-a", "drop", "tester"); err != nil {
+		t.Fatalf("RemoveLabel: %v", err)
+	}
+
+	labels, err := te.store.GetLabels(ctx, "rl-a")
+	if err != nil {
+		t.Fatalf("GetLabels: %v", err)
+	}
+	if len(labels) != 1 || labels[0] != "keep" {
+		t.Errorf("labels: got %v, want [keep]", labels)
+	}
+
+	kept, err := te.store.GetIssuesByLabel(ctx, "keep")
+	if err != nil {
+		t.Fatalf("GetIssuesByLabel(keep): %v", err)
+	}
+	if len(kept) != 1 || kept[0].ID != "rl-a" {
+		t.Errorf("GetIssuesByLabel(keep): got %d issues, want rl-a", len(kept))
+	}
+	dropped, err := te.store.GetIssuesByLabel(ctx, "drop")
+	if err != nil {
+		t.Fatalf("GetIssuesByLabel(drop): %v", err)
+	}
+	if len(dropped) != 0 {
+		t.Errorf("GetIssuesByLabel(drop): got %d issues, want none", len(dropped))
+	}
+
+	var events int
+	te.queryScalar(t, ctx, "SELECT COUNT(*) FROM events WHERE issue_id = ? AND event_type = ?",
+		[]any{"rl-a", string(types.EventLabelRemoved)}, &events)
+	if events != 1 {
+		t.Errorf("label_removed events: got %d, want 1", events)
+	}
+}
@@ -0,0 +1,94 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// History returns every committed version of an issue, newest first, each
+// with the commit that produced it. Callers such as bd history --limit rely
+// on the newest-first order, matching DoltStore.History.
+func (s *EmbeddedDoltStore) History(ctx context.Context, issueID string) ([]*storage.HistoryEntry, error) {
+	var entries []*storage.HistoryEntry
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		// The subquery keeps the planner from assuming a PK lookup on
+		// dolt_history_issues returns a single row; it returns one per commit.
+		//nolint:gosec // G201: IssueSelectColumns is a constant column list
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+			SELECT %s, commit_hash, committer, commit_date
+			FROM (SELECT * FROM dolt_history_issues) h
+			WHERE h.id = ?
+			ORDER BY h.commit_date DESC
+		`, issueops.IssueSelectColumns), issueID)
+		if err != nil {
+			return fmt.Errorf("get issue history: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var entry storage.HistoryEntry
+			issue, err := issueops.ScanIssueFrom(historyRow{rows: rows, extra: []any{
+				&entry.CommitHash, &entry.Committer, &entry.CommitDate,
+			}})
+			if err != nil {
+				return fmt.Errorf("get issue history: scan: %w", err)
+			}
+			entry.Issue = issue
+			entries = append(entries, &entry)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// historyRow adapts a dolt_history_* row, which carries commit columns after
+// the issue columns, to issueops.ScanIssueFrom.
+type historyRow struct {
+	rows  *sql.Rows
+	extra []any
+}
+
+func (h historyRow) Scan(dest ...any) error {
+	return h.rows.Scan(append(dest, h.extra...)...)
+}
+
+// AsOf returns an issue as it existed at a commit hash, branch ref, or
+// timestamp (see issueops.AsOfExpr). Returns storage.ErrNotFound (wrapped) if
+// the issue did not exist at that point, either because it had not been
+// created yet or had been deleted.
+func (s *EmbeddedDoltStore) AsOf(ctx context.Context, issueID string, ref string) (*types.Issue, error) {
+	asOf, err := issueops.AsOfExpr(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ref: %w", err)
+	}
+
+	var issue *types.Issue
+	err = s.withConn(ctx, false, func(tx *sql.Tx) error {
+		//nolint:gosec // G201: asOf is built by issueops.AsOfExpr; AS OF requires a literal
+		row := tx.QueryRowContext(ctx, fmt.Sprintf(
+			`SELECT %s FROM issues AS OF %s WHERE id = ?`, issueops.IssueSelectColumns, asOf), issueID)
+		var err error
+		issue, err = issueops.ScanIssueFrom(row)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%w: issue %s as of %s", storage.ErrNotFound, issueID, ref)
+		}
+		if err != nil {
+			return fmt.Errorf("get issue as of %s: %w", ref, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
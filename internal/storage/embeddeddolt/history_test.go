@@ -0,0 +1,165 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// headHash returns the commit hash HEAD currently points to.
+func (te *testEnv) headHash(t *testing.T) string {
+	t.Helper()
+	var hash string
+	te.queryScalar(t, t.Context(), "SELECT DOLT_HASHOF('HEAD')", nil, &hash)
+	return hash
+}
+
+func TestAsOf(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "ao")
+	ctx := t.Context()
+
+	beforeCreate := te.headHash(t)
+
+	issue := &types.Issue{ID: "ao-a", Title: "Original", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	if err := te.store.Commit(ctx, "create ao-a"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	afterCreate := te.headHash(t)
+
+	if err := te.store.UpdateIssue(ctx, "ao-a", map[string]interface{}{"title": "Renamed"}, "tester"); err != nil {
+		t.Fatalf("UpdateIssue: %v", err)
+	}
+	if err := te.store.Commit(ctx, "rename ao-a"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	t.Run("old_version", func(t *testing.T) {
+		got, err := te.store.AsOf(ctx, "ao-a", afterCreate)
+		if err != nil {
+			t.Fatalf("AsOf: %v", err)
+		}
+		if got.Title != "Original" {
+			t.Errorf("title: got %q, want %q", got.Title, "Original")
+		}
+	})
+
+	t.Run("branch_ref", func(t *testing.T) {
+		got, err := te.store.AsOf(ctx, "ao-a", "main")
+		if err != nil {
+			t.Fatalf("AsOf: %v", err)
+		}
+		if got.Title != "Renamed" {
+			t.Errorf("title: got %q, want %q", got.Title, "Renamed")
+		}
+	})
+
+	t.Run("before_creation", func(t *testing.T) {
+		_, err := te.store.AsOf(ctx, "ao-a", beforeCreate)
+		if !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("timestamp", func(t *testing.T) {
+		var createdAt time.Time
+		te.queryScalar(t, ctx, "SELECT date FROM dolt_log WHERE commit_hash = ?", []any{afterCreate}, &createdAt)
+
+		got, err := te.store.AsOf(ctx, "ao-a", createdAt.UTC().Format(time.RFC3339Nano))
+		if err != nil {
+			t.Fatalf("AsOf: %v", err)
+		}
+		if got.Title != "Original" {
+			t.Errorf("title: got %q, want %q", got.Title, "Original")
+		}
+	})
+
+	t.Run("invalid_ref", func(t *testing.T) {
+		if _, err := te.store.AsOf(ctx, "ao-a", "main'; DROP TABLE issues; --"); err == nil {
+			t.Error("expected error for invalid ref")
+		}
+	})
+
+	t.Run("after_delete", func(t *testing.T) {
+		beforeDelete := te.headHash(t)
+		if err := te.store.DeleteIssue(ctx, "ao-a"); err != nil {
+			t.Fatalf("DeleteIssue: %v", err)
+		}
+		if err := te.store.Commit(ctx, "delete ao-a"); err != nil {
+			t.Fatalf("Commit: %v", err)
+		}
+
+		if _, err := te.store.AsOf(ctx, "ao-a", te.headHash(t)); !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("expected ErrNotFound after delete, got %v", err)
+		}
+		got, err := te.store.AsOf(ctx, "ao-a", beforeDelete)
+		if err != nil {
+			t.Fatalf("AsOf before delete: %v", err)
+		}
+		if got.Title != "Renamed" {
+			t.Errorf("title before delete: got %q, want %q", got.Title, "Renamed")
+		}
+	})
+}
+
+func TestHistory(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "hi")
+	ctx := t.Context()
+
+	issue := &types.Issue{ID: "hi-a", Title: "v1", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	if err := te.store.Commit(ctx, "create hi-a"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	for _, title := range []string{"v2", "v3"} {
+		if err := te.store.UpdateIssue(ctx, "hi-a", map[string]interface{}{"title": title}, "tester"); err != nil {
+			t.Fatalf("UpdateIssue(%s): %v", title, err)
+		}
+		if err := te.store.Commit(ctx, "retitle hi-a"); err != nil {
+			t.Fatalf("Commit: %v", err)
+		}
+	}
+
+	t.Run("all_versions_newest_first", func(t *testing.T) {
+		history, err := te.store.History(ctx, "hi-a")
+		if err != nil {
+			t.Fatalf("History: %v", err)
+		}
+		var titles []string
+		for _, entry := range history {
+			titles = append(titles, entry.Issue.Title)
+			if entry.CommitHash == "" || entry.CommitDate.IsZero() {
+				t.Errorf("entry missing commit info: %+v", entry)
+			}
+		}
+		if len(titles) != 3 || titles[0] != "v3" || titles[1] != "v2" || titles[2] != "v1" {
+			t.Errorf("titles: got %v, want [v3 v2 v1]", titles)
+		}
+		if len(history) == 3 && history[0].CommitHash != te.headHash(t) {
+			t.Errorf("newest entry hash %s is not HEAD", history[0].CommitHash)
+		}
+	})
+
+	t.Run("unknown_issue", func(t *testing.T) {
+		history, err := te.store.History(ctx, "hi-missing")
+		if err != nil {
+			t.Fatalf("History: %v", err)
+		}
+		if len(history) != 0 {
+			t.Errorf("expected no history, got %d entries", len(history))
+		}
+	})
+}
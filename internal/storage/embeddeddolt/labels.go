@@ -7,6 +7,7 @@ import (
 	"database/sql"
 
 	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
 )
 
 func (s *EmbeddedDoltStore) GetLabels(ctx context.Context, issueID string) ([]string, error) {
@@ -24,3 +25,19 @@ func (s *EmbeddedDoltStore) AddLabel(ctx context.Context, issueID, label, actor
 		return issueops.AddLabelInTx(ctx, tx, "", "", issueID, label, actor)
 	})
 }
+
+func (s *EmbeddedDoltStore) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		return issueops.RemoveLabelInTx(ctx, tx, issueID, label, actor)
+	})
+}
+
+func (s *EmbeddedDoltStore) GetIssuesByLabel(ctx context.Context, label string) ([]*types.Issue, error) {
+	var issues []*types.Issue
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		issues, err = issueops.GetIssuesByLabelInTx(ctx, tx, label)
+		return err
+	})
+	return issues, err
+}
@@ -0,0 +1,334 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestGetStaleIssues(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "st")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "st-old", Title: "Old", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "st-older", Title: "Older", Status: types.StatusInProgress, Priority: 2, IssueType: types.TypeTask},
+		{ID: "st-fresh", Title: "Fresh", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "st-closed", Title: "Closed", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	now := time.Now().UTC()
+	for id, age := range map[string]time.Duration{
+		"st-old":    10 * 24 * time.Hour,
+		"st-older":  20 * 24 * time.Hour,
+		"st-closed": 30 * 24 * time.Hour,
+	} {
+		te.exec(t, ctx, "UPDATE issues SET updated_at = ? WHERE id = ?", now.Add(-age), id)
+	}
+
+	t.Run("oldest_first", func(t *testing.T) {
+		stale, err := te.store.GetStaleIssues(ctx, types.StaleFilter{Days: 7})
+		if err != nil {
+			t.Fatalf("GetStaleIssues: %v", err)
+		}
+		if len(stale) != 2 || stale[0].ID != "st-older" || stale[1].ID != "st-old" {
+			var got []string
+			for _, issue := range stale {
+				got = append(got, issue.ID)
+			}
+			t.Errorf("got %v, want [st-older st-old]", got)
+		}
+	})
+
+	t.Run("status_filter", func(t *testing.T) {
+		stale, err := te.store.GetStaleIssues(ctx, types.StaleFilter{Days: 7, Status: string(types.StatusOpen)})
+		if err != nil {
+			t.Fatalf("GetStaleIssues: %v", err)
+		}
+		if len(stale) != 1 || stale[0].ID != "st-old" {
+			t.Errorf("got %d issues, want only st-old", len(stale))
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		stale, err := te.store.GetStaleIssues(ctx, types.StaleFilter{Days: 7, Limit: 1})
+		if err != nil {
+			t.Fatalf("GetStaleIssues: %v", err)
+		}
+		if len(stale) != 1 || stale[0].ID != "st-older" {
+			t.Errorf("got %d issues, want only st-older", len(stale))
+		}
+	})
+}
+
+func TestSearchIssuesCombinedFilters(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "sf")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "sf-1", Title: "open p1 alice", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, Assignee: "alice"},
+		{ID: "sf-2", Title: "open p1 bob", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, Assignee: "bob"},
+		{ID: "sf-3", Title: "open p2 alice", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Assignee: "alice"},
+		{ID: "sf-4", Title: "in progress p1 alice", Status: types.StatusInProgress, Priority: 1, IssueType: types.TypeTask, Assignee: "alice"},
+		{ID: "sf-epic", Title: "parent epic", Status: types.StatusOpen, Priority: 3, IssueType: types.TypeEpic},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	for _, id := range []string{"sf-2", "sf-4"} {
+		dep := &types.Dependency{IssueID: id, DependsOnID: "sf-epic", Type: types.DepParentChild}
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency %s: %v", id, err)
+		}
+	}
+
+	open := types.StatusOpen
+	p1 := 1
+	alice := "alice"
+	epic := "sf-epic"
+	tests := []struct {
+		name   string
+		filter types.IssueFilter
+		want   []string
+	}{
+		{"status_and_priority", types.IssueFilter{Status: &open, Priority: &p1}, []string{"sf-1", "sf-2"}},
+		{"status_and_assignee", types.IssueFilter{Status: &open, Assignee: &alice}, []string{"sf-1", "sf-3"}},
+		{"status_priority_assignee", types.IssueFilter{Status: &open, Priority: &p1, Assignee: &alice}, []string{"sf-1"}},
+		{"parent", types.IssueFilter{ParentID: &epic}, []string{"sf-2", "sf-4"}},
+		{"parent_and_assignee", types.IssueFilter{ParentID: &epic, Assignee: &alice}, []string{"sf-4"}},
+		{"status_and_no_assignee", types.IssueFilter{Status: &open, NoAssignee: true}, []string{"sf-epic"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := te.store.SearchIssues(ctx, "", tt.filter)
+			if err != nil {
+				t.Fatalf("SearchIssues: %v", err)
+			}
+			got := make(map[string]bool, len(results))
+			for _, r := range results {
+				got[r.ID] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("got %d results, want %v", len(got), tt.want)
+			}
+			for _, id := range tt.want {
+				if !got[id] {
+					t.Errorf("missing %s from results", id)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchIssuesNoAssignee(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "na")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "na-assigned", Title: "assigned", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Assignee: "alice"},
+		{ID: "na-free", Title: "unassigned", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+
+	unassignedIDs := func(t *testing.T) []string {
+		t.Helper()
+		results, err := te.store.SearchIssues(ctx, "", types.IssueFilter{NoAssignee: true})
+		if err != nil {
+			t.Fatalf("SearchIssues: %v", err)
+		}
+		ids := make([]string, 0, len(results))
+		for _, r := range results {
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+
+	if got := unassignedIDs(t); len(got) != 1 || got[0] != "na-free" {
+		t.Errorf("unassigned before transition = %v, want [na-free]", got)
+	}
+
+	// Assign the free issue and unassign the other one.
+	if err := te.store.UpdateIssue(ctx, "na-free", map[string]interface{}{"assignee": "bob"}, "tester"); err != nil {
+		t.Fatalf("UpdateIssue assign: %v", err)
+	}
+	if err := te.store.UpdateIssue(ctx, "na-assigned", map[string]interface{}{"assignee": ""}, "tester"); err != nil {
+		t.Fatalf("UpdateIssue unassign: %v", err)
+	}
+
+	if got := unassignedIDs(t); len(got) != 1 || got[0] != "na-assigned" {
+		t.Errorf("unassigned after transition = %v, want [na-assigned]", got)
+	}
+}
+
+func TestSearchIssuesStatusPriorityOrder(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "sp")
+	ctx := t.Context()
+
+	// Priorities are created out of order and mixed with other statuses, so
+	// the result order must come from the (status, priority) lookup, not
+	// insertion order.
+	for _, issue := range []*types.Issue{
+		{ID: "sp-p3", Title: "p3", Status: types.StatusOpen, Priority: 3, IssueType: types.TypeTask},
+		{ID: "sp-p0", Title: "p0", Status: types.StatusOpen, Priority: 0, IssueType: types.TypeTask},
+		{ID: "sp-wip", Title: "wip", Status: types.StatusInProgress, Priority: 0, IssueType: types.TypeTask},
+		{ID: "sp-p4", Title: "p4", Status: types.StatusOpen, Priority: 4, IssueType: types.TypeTask},
+		{ID: "sp-p1", Title: "p1", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask},
+		{ID: "sp-blocked", Title: "blocked", Status: types.StatusBlocked, Priority: 1, IssueType: types.TypeTask},
+		{ID: "sp-p2", Title: "p2", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	want := []string{"sp-p0", "sp-p1", "sp-p2", "sp-p3", "sp-p4"}
+
+	ids := func(issues []*types.Issue) []string {
+		out := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			out = append(out, issue.ID)
+		}
+		return out
+	}
+
+	open := types.StatusOpen
+	results, err := te.store.SearchIssues(ctx, "", types.IssueFilter{Status: &open})
+	if err != nil {
+		t.Fatalf("SearchIssues: %v", err)
+	}
+	if got := ids(results); !slices.Equal(got, want) {
+		t.Errorf("SearchIssues order = %v, want %v", got, want)
+	}
+
+	ready, err := te.store.GetReadyWork(ctx, types.WorkFilter{Status: types.StatusOpen, SortPolicy: types.SortPolicyPriority})
+	if err != nil {
+		t.Fatalf("GetReadyWork: %v", err)
+	}
+	if got := ids(ready); !slices.Equal(got, want) {
+		t.Errorf("GetReadyWork order = %v, want %v", got, want)
+	}
+}
+
+func TestSearchIssuesLabelFilters(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "lf")
+	ctx := t.Context()
+
+	labels := map[string][]string{
+		"lf-both":    {"backend", "urgent"},
+		"lf-backend": {"backend"},
+		"lf-urgent":  {"urgent"},
+		"lf-none":    nil,
+	}
+	for id, issueLabels := range labels {
+		issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", id, err)
+		}
+		for _, label := range issueLabels {
+			if err := te.store.AddLabel(ctx, id, label, "tester"); err != nil {
+				t.Fatalf("AddLabel %s %s: %v", id, label, err)
+			}
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter types.IssueFilter
+		want   []string
+	}{
+		{"all_labels", types.IssueFilter{Labels: []string{"backend", "urgent"}}, []string{"lf-both"}},
+		{"any_label", types.IssueFilter{LabelsAny: []string{"backend", "urgent"}}, []string{"lf-both", "lf-backend", "lf-urgent"}},
+		{"empty_labels", types.IssueFilter{Labels: []string{}}, []string{"lf-both", "lf-backend", "lf-urgent", "lf-none"}},
+		{"no_labels", types.IssueFilter{NoLabels: true}, []string{"lf-none"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := te.store.SearchIssues(ctx, "", tt.filter)
+			if err != nil {
+				t.Fatalf("SearchIssues: %v", err)
+			}
+			got := make(map[string]bool, len(results))
+			for _, r := range results {
+				got[r.ID] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("got %d results, want %v", len(got), tt.want)
+			}
+			for _, id := range tt.want {
+				if !got[id] {
+					t.Errorf("missing %s from results", id)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchIssuesTextQuery(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "tq")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "tq-1", Title: "Fix Login timeout", Description: "users are logged out", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeBug},
+		{ID: "tq-2", Title: "Refactor session store", Description: "login flow touches this", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "tq-3", Title: "Unrelated", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		filter types.IssueFilter
+		want   []string
+	}{
+		// The free-text query matches titles and IDs, case-insensitively.
+		{"title_case_insensitive", "LOGIN", types.IssueFilter{}, []string{"tq-1"}},
+		{"id", "tq-3", types.IssueFilter{}, []string{"tq-3"}},
+		// Description matching is opt-in through DescriptionContains.
+		{"description", "", types.IssueFilter{DescriptionContains: "Login"}, []string{"tq-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := te.store.SearchIssues(ctx, tt.query, tt.filter)
+			if err != nil {
+				t.Fatalf("SearchIssues: %v", err)
+			}
+			got := make(map[string]bool, len(results))
+			for _, r := range results {
+				got[r.ID] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("got %d results, want %v", len(got), tt.want)
+			}
+			for _, id := range tt.want {
+				if !got[id] {
+					t.Errorf("missing %s from results", id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func (s *EmbeddedDoltStore) GetMoleculeProgress(ctx context.Context, moleculeID string) (*types.MoleculeProgressStats, error) {
+	var stats *types.MoleculeProgressStats
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		stats, err = issueops.GetMoleculeProgressInTx(ctx, tx, moleculeID)
+		return err
+	})
+	return stats, err
+}
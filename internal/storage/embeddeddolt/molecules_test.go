@@ -0,0 +1,60 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestGetMoleculeProgress(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "mp")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "mp-mol", Title: "Release", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeEpic},
+		{ID: "mp-1", Title: "step 1", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask},
+		{ID: "mp-2", Title: "step 2", Status: types.StatusInProgress, Priority: 2, IssueType: types.TypeTask},
+		{ID: "mp-3", Title: "step 3", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "mp-other", Title: "related", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	for _, id := range []string{"mp-1", "mp-2", "mp-3"} {
+		dep := &types.Dependency{IssueID: id, DependsOnID: "mp-mol", Type: types.DepParentChild}
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency %s: %v", id, err)
+		}
+	}
+	// Non parent-child edges are not steps.
+	if err := te.store.AddDependency(ctx, &types.Dependency{IssueID: "mp-other", DependsOnID: "mp-mol", Type: types.DepRelated}, "tester"); err != nil {
+		t.Fatalf("AddDependency related: %v", err)
+	}
+
+	stats, err := te.store.GetMoleculeProgress(ctx, "mp-mol")
+	if err != nil {
+		t.Fatalf("GetMoleculeProgress: %v", err)
+	}
+	if stats.MoleculeTitle != "Release" {
+		t.Errorf("MoleculeTitle: got %q, want %q", stats.MoleculeTitle, "Release")
+	}
+	if stats.Total != 3 || stats.Completed != 1 || stats.InProgress != 1 {
+		t.Errorf("got total=%d completed=%d in_progress=%d, want 3/1/1", stats.Total, stats.Completed, stats.InProgress)
+	}
+	if stats.CurrentStepID != "mp-2" {
+		t.Errorf("CurrentStepID: got %q, want mp-2", stats.CurrentStepID)
+	}
+
+	empty, err := te.store.GetMoleculeProgress(ctx, "mp-3")
+	if err != nil {
+		t.Fatalf("GetMoleculeProgress(mp-3): %v", err)
+	}
+	if empty.Total != 0 {
+		t.Errorf("GetMoleculeProgress(mp-3): got total=%d, want 0", empty.Total)
+	}
+}
@@ -0,0 +1,280 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// readyWorkExcludedTypes are workflow and identity types that are never
+// actionable work unless the filter asks for a type explicitly. Keep in sync
+// with DoltStore.GetReadyWork.
+var readyWorkExcludedTypes = []string{"merge-request", "gate", "molecule", "message", "agent", "role", "rig"}
+
+// GetReadyWork returns active issues that are not blocked, ordered by the
+// filter's SortPolicy. Like GetBlockedIssues, it uses single-table queries
+// and applies blocking, parent, and label constraints in Go.
+func (s *EmbeddedDoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) ([]*types.Issue, error) {
+	var issues []*types.Issue
+	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var err error
+		issues, err = getReadyWorkInTx(ctx, tx, filter)
+		return err
+	})
+	return issues, err
+}
+
+func getReadyWorkInTx(ctx context.Context, tx *sql.Tx, filter types.WorkFilter) ([]*types.Issue, error) {
+	issueTables := []string{"issues"}
+	depTables := []string{"dependencies"}
+	if filter.IncludeEphemeral {
+		issueTables = append(issueTables, "wisps")
+		depTables = append(depTables, "wisp_dependencies")
+	}
+
+	if err := storage.CheckReadyWorkFilter(filter); err != nil {
+		return nil, err
+	}
+	whereSQL, args, err := readyWorkWhere(filter)
+	if err != nil {
+		return nil, err
+	}
+	orderBySQL := readyWorkOrderBy(filter.SortPolicy)
+
+	var candidateIDs []string
+	for _, table := range issueTables {
+		//nolint:gosec // G201: table is hardcoded, whereSQL contains only ? placeholders
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf(`SELECT id FROM %s WHERE %s %s`, table, whereSQL, orderBySQL), args...)
+		if err != nil {
+			return nil, fmt.Errorf("get ready work: candidates from %s: %w", table, err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("get ready work: scan candidate: %w", err)
+			}
+			candidateIDs = append(candidateIDs, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("get ready work: candidate rows from %s: %w", table, err)
+		}
+	}
+	if len(candidateIDs) == 0 {
+		return nil, nil
+	}
+
+	// Blocked issues and children of blocked parents are not ready (GH#1495).
+	excluded := make(map[string]bool)
+	blockedIDs, err := computeBlockedIDs(ctx, tx, filter.IncludeEphemeral)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range blockedIDs {
+		excluded[id] = true
+	}
+	childrenOfBlocked, err := getChildrenWithParents(ctx, tx, blockedIDs, depTables)
+	if err != nil {
+		return nil, err
+	}
+	for childID := range childrenOfBlocked {
+		excluded[childID] = true
+	}
+
+	// Children of future-deferred parents wait with their parent (GH#1190).
+	if !filter.IncludeDeferred {
+		deferredIDs, err := getDeferredIDs(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		childrenOfDeferred, err := getChildrenWithParents(ctx, tx, deferredIDs, depTables)
+		if err != nil {
+			return nil, err
+		}
+		for childID := range childrenOfDeferred {
+			excluded[childID] = true
+		}
+	}
+
+	// Restrict to children of the requested parent, including dotted-ID
+	// children such as "parent.1.2" (GH#2009). An explicit parent-child edge
+	// takes precedence over the dotted-ID prefix, as in DoltStore.
+	var parentChildSet, reparented map[string]bool
+	if filter.ParentID != nil {
+		children, err := getChildrenWithParents(ctx, tx, []string{*filter.ParentID}, depTables)
+		if err != nil {
+			return nil, err
+		}
+		parentChildSet = make(map[string]bool, len(children))
+		for childID := range children {
+			parentChildSet[childID] = true
+		}
+		reparented, err = getDottedChildrenWithParentEdge(ctx, tx, *filter.ParentID, depTables)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	readyIDs := make([]string, 0, len(candidateIDs))
+	for _, id := range candidateIDs {
+		if excluded[id] {
+			continue
+		}
+		if parentChildSet != nil && !parentChildSet[id] &&
+			(!strings.HasPrefix(id, *filter.ParentID+".") || reparented[id]) {
+			continue
+		}
+		readyIDs = append(readyIDs, id)
+	}
+
+	issues, err := issueops.GetIssuesInOrderInTx(ctx, tx, readyIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get ready work: %w", err)
+	}
+	var results []*types.Issue
+	for _, issue := range issues {
+		if !filter.Matches(issue) {
+			continue
+		}
+		results = append(results, issue)
+		if filter.Limit > 0 && len(results) == filter.Limit {
+			break
+		}
+	}
+	return results, nil
+}
+
+// readyWorkWhere builds the column-level WHERE clause for ready work
+// candidates. Everything that needs another table is handled by the caller.
+func readyWorkWhere(filter types.WorkFilter) (string, []any, error) {
+	var clauses []string
+	var args []any
+
+	if filter.Status != "" {
+		clauses = append(clauses, "status = ?")
+		args = append(args, string(filter.Status))
+	} else {
+		clauses = append(clauses, "status IN ('open', 'in_progress')")
+	}
+	// Pinned issues are context markers, not work.
+	clauses = append(clauses, "(pinned = 0 OR pinned IS NULL)")
+	if !filter.IncludeEphemeral {
+		clauses = append(clauses, "(ephemeral = 0 OR ephemeral IS NULL)")
+	}
+	if filter.Type == "" && len(filter.Types) == 0 {
+		clauses = append(clauses, "issue_type NOT IN ("+strings.TrimSuffix(strings.Repeat("?,", len(readyWorkExcludedTypes)), ",")+")")
+		for _, t := range readyWorkExcludedTypes {
+			args = append(args, t)
+		}
+	}
+	if !filter.IncludeDeferred {
+		clauses = append(clauses, "(defer_until IS NULL OR defer_until <= NOW())")
+	}
+	if filter.MolType != nil {
+		clauses = append(clauses, "mol_type = ?")
+		args = append(args, string(*filter.MolType))
+	}
+	if filter.WispType != nil {
+		clauses = append(clauses, "wisp_type = ?")
+		args = append(args, string(*filter.WispType))
+	}
+
+	// Metadata filters (GH#1406)
+	if filter.HasMetadataKey != "" {
+		if err := storage.ValidateMetadataKey(filter.HasMetadataKey); err != nil {
+			return "", nil, err
+		}
+		clauses = append(clauses, "JSON_EXTRACT(metadata, ?) IS NOT NULL")
+		args = append(args, "$."+filter.HasMetadataKey)
+	}
+	metaKeys := make([]string, 0, len(filter.MetadataFields))
+	for k := range filter.MetadataFields {
+		metaKeys = append(metaKeys, k)
+	}
+	sort.Strings(metaKeys)
+	for _, k := range metaKeys {
+		if err := storage.ValidateMetadataKey(k); err != nil {
+			return "", nil, err
+		}
+		clauses = append(clauses, "JSON_UNQUOTE(JSON_EXTRACT(metadata, ?)) = ?")
+		args = append(args, "$."+k, filter.MetadataFields[k])
+	}
+
+	return strings.Join(clauses, " AND "), args, nil
+}
+
+// readyWorkOrderBy returns the ORDER BY clause for a sort policy, matching
+// DoltStore.GetReadyWork.
+func readyWorkOrderBy(policy types.SortPolicy) string {
+	switch policy {
+	case types.SortPolicyOldest:
+		return "ORDER BY created_at ASC, id ASC"
+	case types.SortPolicyHybrid, "":
+		// Recent issues (created within 48 hours) are sorted by priority;
+		// older issues are sorted by age to prevent starvation.
+		return `ORDER BY
+			CASE WHEN created_at >= DATE_SUB(NOW(), INTERVAL 48 HOUR) THEN 0 ELSE 1 END ASC,
+			CASE WHEN created_at >= DATE_SUB(NOW(), INTERVAL 48 HOUR) THEN priority ELSE 999 END ASC,
+			created_at ASC, id ASC`
+	default:
+		return "ORDER BY priority ASC, created_at DESC, id ASC"
+	}
+}
+
+// getDottedChildrenWithParentEdge returns IDs under the dotted prefix of
+// parentID that have a parent-child edge to any issue.
+func getDottedChildrenWithParentEdge(ctx context.Context, tx *sql.Tx, parentID string, depTables []string) (map[string]bool, error) {
+	result := make(map[string]bool)
+	for _, depTable := range depTables {
+		//nolint:gosec // G201: depTable is hardcoded to "dependencies" or "wisp_dependencies"
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf(
+			`SELECT issue_id FROM %s WHERE type = 'parent-child' AND issue_id LIKE CONCAT(?, '.%%')`, depTable), parentID)
+		if err != nil {
+			return nil, fmt.Errorf("get ready work: parent edges from %s: %w", depTable, err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("get ready work: scan parent edge: %w", err)
+			}
+			result[id] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("get ready work: parent edge rows from %s: %w", depTable, err)
+		}
+	}
+	return result, nil
+}
+
+// getDeferredIDs returns IDs of issues whose defer_until is in the future.
+func getDeferredIDs(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM issues WHERE defer_until IS NOT NULL AND defer_until > NOW()`)
+	if err != nil {
+		return nil, fmt.Errorf("get ready work: deferred issues: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("get ready work: scan deferred issue: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get ready work: deferred rows: %w", err)
+	}
+	return ids, nil
+}
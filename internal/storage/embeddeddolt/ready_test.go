@@ -0,0 +1,147 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestGetReadyWork(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "rw")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "rw-p0", Title: "P0 bug", Status: types.StatusOpen, Priority: 0, IssueType: types.TypeBug},
+		{ID: "rw-p1", Title: "P1 task", Status: types.StatusInProgress, Priority: 1, IssueType: types.TypeTask, Assignee: "alice"},
+		{ID: "rw-p3", Title: "P3 chore", Status: types.StatusOpen, Priority: 3, IssueType: types.TypeChore},
+		{ID: "rw-human", Title: "Needs a human", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask},
+		{ID: "rw-blocked", Title: "Blocked", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask},
+		{ID: "rw-child", Title: "Child of blocked", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask},
+		{ID: "rw-closed", Title: "Closed", Status: types.StatusClosed, Priority: 0, IssueType: types.TypeTask},
+		{ID: "rw-msg", Title: "Message", Status: types.StatusOpen, Priority: 0, IssueType: types.TypeMessage},
+		{ID: "rw-swarm", Title: "Swarm work", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeFeature, Assignee: "bob", MolType: types.MolTypeSwarm},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	for _, dep := range []*types.Dependency{
+		{IssueID: "rw-blocked", DependsOnID: "rw-p3", Type: types.DepBlocks},
+		{IssueID: "rw-child", DependsOnID: "rw-blocked", Type: types.DepParentChild},
+	} {
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency %s -> %s: %v", dep.IssueID, dep.DependsOnID, err)
+		}
+	}
+	if err := te.store.AddLabel(ctx, "rw-human", "needs-human", "tester"); err != nil {
+		t.Fatalf("AddLabel: %v", err)
+	}
+
+	intPtr := func(n int) *int { return &n }
+	molTypePtr := func(m types.MolType) *types.MolType { return &m }
+
+	tests := []struct {
+		name   string
+		filter types.WorkFilter
+		want   []string
+	}{
+		{"default", types.WorkFilter{}, []string{"rw-human", "rw-p0", "rw-p1", "rw-p3", "rw-swarm"}},
+		{"priority_floor", types.WorkFilter{PriorityMax: intPtr(1)}, []string{"rw-human", "rw-p0", "rw-p1"}},
+		{"priority_floor_zero", types.WorkFilter{PriorityMax: intPtr(0)}, []string{"rw-p0"}},
+		{"types_allow_list", types.WorkFilter{Types: []string{"bug", "chore"}}, []string{"rw-p0", "rw-p3"}},
+		{"explicit_type_with_ephemeral", types.WorkFilter{Type: "message", IncludeEphemeral: true}, []string{"rw-msg"}},
+		{"exclude_labels", types.WorkFilter{ExcludeLabels: []string{"needs-human"}}, []string{"rw-p0", "rw-p1", "rw-p3", "rw-swarm"}},
+		{"status", types.WorkFilter{Status: types.StatusInProgress}, []string{"rw-p1"}},
+		{"unassigned", types.WorkFilter{Unassigned: true}, []string{"rw-human", "rw-p0", "rw-p3"}},
+		{"mol_type", types.WorkFilter{MolType: molTypePtr(types.MolTypeSwarm)}, []string{"rw-swarm"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := te.store.GetReadyWork(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("GetReadyWork: %v", err)
+			}
+			got := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				got = append(got, issue.ID)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unsupported_label_filters", func(t *testing.T) {
+		for _, filter := range []types.WorkFilter{{LabelPattern: "needs-*"}, {LabelRegex: "needs-.*"}} {
+			if _, err := te.store.GetReadyWork(ctx, filter); !errors.Is(err, storage.ErrUnsupportedFilter) {
+				t.Errorf("GetReadyWork(%+v) error = %v, want ErrUnsupportedFilter", filter, err)
+			}
+		}
+	})
+
+	t.Run("priority_sort_and_limit", func(t *testing.T) {
+		issues, err := te.store.GetReadyWork(ctx, types.WorkFilter{SortPolicy: types.SortPolicyPriority, Limit: 2})
+		if err != nil {
+			t.Fatalf("GetReadyWork: %v", err)
+		}
+		if len(issues) != 2 {
+			t.Fatalf("got %d issues, want 2", len(issues))
+		}
+		if issues[0].ID != "rw-p0" || issues[1].Priority != 1 {
+			t.Errorf("got [%s P%d, %s P%d], want rw-p0 first then a P1",
+				issues[0].ID, issues[0].Priority, issues[1].ID, issues[1].Priority)
+		}
+	})
+}
+
+func TestGetReadyWorkParentFilter(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "rp")
+	ctx := t.Context()
+
+	for _, issue := range []*types.Issue{
+		{ID: "rp-epic", Title: "Epic", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeEpic},
+		{ID: "rp-other", Title: "Other epic", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeEpic},
+		{ID: "rp-epic.1", Title: "Dotted child", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask},
+		{ID: "rp-epic.2", Title: "Dotted but reparented", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask},
+		{ID: "rp-linked", Title: "Explicit child", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask},
+	} {
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	for _, dep := range []*types.Dependency{
+		{IssueID: "rp-epic.2", DependsOnID: "rp-other", Type: types.DepParentChild},
+		{IssueID: "rp-linked", DependsOnID: "rp-epic", Type: types.DepParentChild},
+	} {
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency %s -> %s: %v", dep.IssueID, dep.DependsOnID, err)
+		}
+	}
+
+	parentID := "rp-epic"
+	issues, err := te.store.GetReadyWork(ctx, types.WorkFilter{ParentID: &parentID})
+	if err != nil {
+		t.Fatalf("GetReadyWork: %v", err)
+	}
+	got := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		got = append(got, issue.ID)
+	}
+	sort.Strings(got)
+	// rp-epic.2 has an explicit edge to rp-other, which wins over its prefix.
+	if want := "rp-epic.1,rp-linked"; strings.Join(got, ",") != want {
+		t.Errorf("got %v, want %s", got, want)
+	}
+}
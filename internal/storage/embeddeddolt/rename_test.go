@@ -0,0 +1,103 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestUpdateIssueID(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("rewrites_references", func(t *testing.T) {
+		te := newTestEnv(t, "rn")
+		ctx := t.Context()
+
+		for _, id := range []string{"rn-old", "rn-up", "rn-down"} {
+			issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", id, err)
+			}
+		}
+		for _, dep := range []*types.Dependency{
+			{IssueID: "rn-old", DependsOnID: "rn-up", Type: types.DepBlocks},
+			{IssueID: "rn-down", DependsOnID: "rn-old", Type: types.DepBlocks},
+		} {
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s->%s: %v", dep.IssueID, dep.DependsOnID, err)
+			}
+		}
+		if err := te.store.AddLabel(ctx, "rn-old", "backend", "tester"); err != nil {
+			t.Fatalf("AddLabel: %v", err)
+		}
+		if _, err := te.store.AddIssueComment(ctx, "rn-old", "alice", "a comment"); err != nil {
+			t.Fatalf("AddIssueComment: %v", err)
+		}
+
+		renamed := &types.Issue{ID: "rn-new", Title: "Renamed", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.UpdateIssueID(ctx, "rn-old", "rn-new", renamed, "tester"); err != nil {
+			t.Fatalf("UpdateIssueID: %v", err)
+		}
+
+		te.assertRowNotExists(t, ctx, "issues", "rn-old")
+		te.assertIssueTitle(t, ctx, "issues", "rn-new", "Renamed")
+		te.assertLabelCount(t, ctx, "labels", "rn-new", 1)
+		te.assertLabelCount(t, ctx, "labels", "rn-old", 0)
+		te.assertEventCount(t, ctx, "events", "rn-new", "renamed", 1)
+		te.assertEventCount(t, ctx, "events", "rn-new", "created", 1)
+
+		var count int
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE issue_id = ? AND depends_on_id = ?", []any{"rn-new", "rn-up"}, &count)
+		if count != 1 {
+			t.Errorf("rn-new -> rn-up dependency: got %d rows, want 1", count)
+		}
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE issue_id = ? AND depends_on_id = ?", []any{"rn-down", "rn-new"}, &count)
+		if count != 1 {
+			t.Errorf("rn-down -> rn-new dependency: got %d rows, want 1", count)
+		}
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE issue_id = ? OR depends_on_id = ?", []any{"rn-old", "rn-old"}, &count)
+		if count != 0 {
+			t.Errorf("dependencies still referencing rn-old: got %d rows, want 0", count)
+		}
+
+		comments, err := te.store.GetIssueComments(ctx, "rn-new")
+		if err != nil {
+			t.Fatalf("GetIssueComments: %v", err)
+		}
+		if len(comments) != 1 || comments[0].Text != "a comment" {
+			t.Errorf("comments for rn-new: got %+v, want one comment", comments)
+		}
+	})
+
+	t.Run("wisp", func(t *testing.T) {
+		te := newTestEnv(t, "rw")
+		ctx := t.Context()
+
+		wisp := &types.Issue{ID: "rw-old", Title: "wisp", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true}
+		if err := te.store.CreateIssue(ctx, wisp, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+
+		renamed := &types.Issue{ID: "rw-new", Title: "wisp renamed", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.UpdateIssueID(ctx, "rw-old", "rw-new", renamed, "tester"); err != nil {
+			t.Fatalf("UpdateIssueID: %v", err)
+		}
+
+		te.assertRowNotExists(t, ctx, "wisps", "rw-old")
+		te.assertIssueTitle(t, ctx, "wisps", "rw-new", "wisp renamed")
+		te.assertRowNotExists(t, ctx, "issues", "rw-new")
+		te.assertEventCount(t, ctx, "wisp_events", "rw-new", "renamed", 1)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		te := newTestEnv(t, "rf")
+		ctx := t.Context()
+
+		renamed := &types.Issue{ID: "rf-new", Title: "x", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.UpdateIssueID(ctx, "rf-missing", "rf-new", renamed, "tester"); err == nil {
+			t.Fatal("expected error renaming a nonexistent issue")
+		}
+	})
+}
@@ -0,0 +1,2 @@
+DROP INDEX idx_wisps_status_priority ON wisps;
+DROP INDEX idx_issues_status_priority ON issues;
@@ -0,0 +1,2 @@
+CREATE INDEX idx_issues_status_priority ON issues (status, priority);
+CREATE INDEX idx_wisps_status_priority ON wisps (status, priority);
@@ -91,14 +91,14 @@ func TestSchemaAfterInit(t *testing.T) {
 		"issues": {
 			"defer_until", "due_at", "rig", "role_type", "agent_state",
 			"hook_bead", "role_bead", "await_type", "event_kind",
-			"idx_issues_status", "idx_issues_external_ref",
+			"idx_issues_status", "idx_issues_status_priority", "idx_issues_external_ref",
 		},
 		"dependencies": {
 			"thread_id", "metadata", "idx_dependencies_thread",
 			"idx_dependencies_depends_on_type", "fk_dep_issue",
 		},
 		"wisps": {
-			"defer_until", "due_at", "rig", "idx_wisps_status",
+			"defer_until", "due_at", "rig", "idx_wisps_status", "idx_wisps_status_priority",
 		},
 		"wisp_dependencies": {
 			"thread_id", "metadata", "idx_wisp_dep_depends",
@@ -145,8 +145,8 @@ func TestSchemaAfterInit(t *testing.T) {
 	if err := db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_migrations").Scan(&maxVersion); err != nil {
 		t.Fatalf("reading max migration version: %v", err)
 	}
-	if maxVersion != 23 {
-		t.Errorf("max migration version: got %d, want 23", maxVersion)
+	if maxVersion != 24 {
+		t.Errorf("max migration version: got %d, want 24", maxVersion)
 	}
 
 	// --- Log all tables for debugging ---
@@ -180,15 +180,15 @@ func TestSchemaAfterInit(t *testing.T) {
 	if err := db2.QueryRowContext(ctx, "SELECT COUNT(*) FROM schema_migrations").Scan(&migrationCount); err != nil {
 		t.Fatalf("counting migrations: %v", err)
 	}
-	if migrationCount != 23 {
-		t.Errorf("migration count after second init: got %d, want 23", migrationCount)
+	if migrationCount != 24 {
+		t.Errorf("migration count after second init: got %d, want 24", migrationCount)
 	}
 
 	if err := db2.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_migrations").Scan(&maxVersion); err != nil {
 		t.Fatalf("reading max version after second init: %v", err)
 	}
-	if maxVersion != 23 {
-		t.Errorf("max version after second init: got %d, want 23", maxVersion)
+	if maxVersion != 24 {
+		t.Errorf("max version after second init: got %d, want 24", maxVersion)
 	}
 
 	cleanup2()
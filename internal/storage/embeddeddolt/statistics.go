@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/steveyegge/beads/internal/storage/issueops"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -33,6 +34,12 @@ func (s *EmbeddedDoltStore) GetStatistics(ctx context.Context) (*types.Statistic
 			return err
 		}
 
+		byStatus, err := issueops.CountIssuesByStatusInTx(ctx, tx)
+		if err != nil {
+			return err
+		}
+		stats.ByStatus = byStatus
+
 		blockedIDs, err := computeBlockedIDs(ctx, tx, true)
 		if err != nil {
 			return err
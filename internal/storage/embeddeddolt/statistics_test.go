@@ -0,0 +1,62 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestGetStatistics(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	te := newTestEnv(t, "gs")
+	ctx := t.Context()
+
+	if err := te.store.SetConfig(ctx, "status.custom", "review"); err != nil {
+		t.Fatalf("SetConfig: %v", err)
+	}
+
+	// More than 1000 open issues, so a capped count would show up.
+	const openCount = 1005
+	issues := make([]*types.Issue, 0, openCount+2)
+	for i := 0; i < openCount; i++ {
+		issues = append(issues, &types.Issue{
+			ID: fmt.Sprintf("gs-%d", i), Title: "open", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask,
+		})
+	}
+	issues = append(issues,
+		&types.Issue{ID: "gs-wip", Title: "wip", Status: types.StatusInProgress, Priority: 2, IssueType: types.TypeTask},
+		&types.Issue{ID: "gs-review", Title: "review", Status: types.Status("review"), Priority: 2, IssueType: types.TypeTask},
+	)
+	if err := te.store.CreateIssues(ctx, issues, "tester"); err != nil {
+		t.Fatalf("CreateIssues: %v", err)
+	}
+
+	stats, err := te.store.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetStatistics: %v", err)
+	}
+	if stats.TotalIssues != openCount+2 {
+		t.Errorf("TotalIssues: got %d, want %d", stats.TotalIssues, openCount+2)
+	}
+	if stats.OpenIssues != openCount {
+		t.Errorf("OpenIssues: got %d, want %d", stats.OpenIssues, openCount)
+	}
+
+	want := map[types.Status]int64{
+		types.StatusOpen:       openCount,
+		types.StatusInProgress: 1,
+		types.Status("review"): 1,
+	}
+	if len(stats.ByStatus) != len(want) {
+		t.Errorf("ByStatus: got %v, want %v", stats.ByStatus, want)
+	}
+	for status, count := range want {
+		if got := stats.ByStatus[status]; got != count {
+			t.Errorf("ByStatus[%s]: got %d, want %d", status, got, count)
+		}
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"os"
 	"path/filepath"
 	"sync/atomic"
-	"time"
 
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/storage"
@@ -192,47 +191,27 @@ func (s *EmbeddedDoltStore) initSchema(ctx context.Context) error {
 
 // GetIssue is implemented in get_issue.go.
 
-func (s *EmbeddedDoltStore) GetIssueByExternalRef(ctx context.Context, externalRef string) (*types.Issue, error) {
-	panic("embeddeddolt: GetIssueByExternalRef not implemented")
-}
+// GetIssueByExternalRef is implemented in get_issue.go.
 
-func (s *EmbeddedDoltStore) GetIssuesByIDs(ctx context.Context, ids []string) ([]*types.Issue, error) {
-	panic("embeddeddolt: GetIssuesByIDs not implemented")
-}
+// GetIssuesByIDs is implemented in get_issue.go.
 
-func (s *EmbeddedDoltStore) UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error {
-	panic("embeddeddolt: UpdateIssue not implemented")
-}
+// UpdateIssue is implemented in update_issue.go.
 
-func (s *EmbeddedDoltStore) CloseIssue(ctx context.Context, id string, reason string, actor string, session string) error {
-	panic("embeddeddolt: CloseIssue not implemented")
-}
+// CloseIssue is implemented in close_issue.go.
 
-func (s *EmbeddedDoltStore) DeleteIssue(ctx context.Context, id string) error {
-	panic("embeddeddolt: DeleteIssue not implemented")
-}
+// DeleteIssue is implemented in delete_issue.go.
 
 // AddDependency is implemented in dependencies.go.
 
-func (s *EmbeddedDoltStore) RemoveDependency(ctx context.Context, issueID, dependsOnID string, actor string) error {
-	panic("embeddeddolt: RemoveDependency not implemented")
-}
+// RemoveDependency is implemented in dependencies.go.
 
-func (s *EmbeddedDoltStore) GetDependencies(ctx context.Context, issueID string) ([]*types.Issue, error) {
-	panic("embeddeddolt: GetDependencies not implemented")
-}
+// GetDependencies is implemented in dependencies.go.
 
-func (s *EmbeddedDoltStore) GetDependents(ctx context.Context, issueID string) ([]*types.Issue, error) {
-	panic("embeddeddolt: GetDependents not implemented")
-}
+// GetDependents is implemented in dependencies.go.
 
-func (s *EmbeddedDoltStore) GetDependenciesWithMetadata(ctx context.Context, issueID string) ([]*types.IssueWithDependencyMetadata, error) {
-	panic("embeddeddolt: GetDependenciesWithMetadata not implemented")
-}
+// GetDependenciesWithMetadata is implemented in dependencies.go.
 
-func (s *EmbeddedDoltStore) GetDependentsWithMetadata(ctx context.Context, issueID string) ([]*types.IssueWithDependencyMetadata, error) {
-	panic("embeddeddolt: GetDependentsWithMetadata not implemented")
-}
+// GetDependentsWithMetadata is implemented in dependencies.go.
 
 func (s *EmbeddedDoltStore) GetDependencyTree(ctx context.Context, issueID string, maxDepth int, showAllPaths bool, reverse bool) ([]*types.TreeNode, error) {
 	panic("embeddeddolt: GetDependencyTree not implemented")
@@ -240,47 +219,27 @@ func (s *EmbeddedDoltStore) GetDependencyTree(ctx context.Context, issueID strin
 
 // AddLabel is implemented in labels.go.
 
-func (s *EmbeddedDoltStore) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
-	panic("embeddeddolt: RemoveLabel not implemented")
-}
+// RemoveLabel is implemented in labels.go.
 
 // GetLabels is implemented in labels.go.
 
-func (s *EmbeddedDoltStore) GetIssuesByLabel(ctx context.Context, label string) ([]*types.Issue, error) {
-	panic("embeddeddolt: GetIssuesByLabel not implemented")
-}
+// GetIssuesByLabel is implemented in labels.go.
 
-func (s *EmbeddedDoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) ([]*types.Issue, error) {
-	panic("embeddeddolt: GetReadyWork not implemented")
-}
+// GetReadyWork is implemented in ready.go.
 
-func (s *EmbeddedDoltStore) GetBlockedIssues(ctx context.Context, filter types.WorkFilter) ([]*types.BlockedIssue, error) {
-	panic("embeddeddolt: GetBlockedIssues not implemented")
-}
+// GetBlockedIssues is implemented in blocked.go.
 
-func (s *EmbeddedDoltStore) GetEpicsEligibleForClosure(ctx context.Context) ([]*types.EpicStatus, error) {
-	panic("embeddeddolt: GetEpicsEligibleForClosure not implemented")
-}
+// GetEpicsEligibleForClosure is implemented in epics.go.
 
-func (s *EmbeddedDoltStore) AddIssueComment(ctx context.Context, issueID, author, text string) (*types.Comment, error) {
-	panic("embeddeddolt: AddIssueComment not implemented")
-}
+// AddIssueComment is implemented in comments.go.
 
-func (s *EmbeddedDoltStore) GetIssueComments(ctx context.Context, issueID string) ([]*types.Comment, error) {
-	panic("embeddeddolt: GetIssueComments not implemented")
-}
+// GetIssueComments is implemented in comments.go.
 
-func (s *EmbeddedDoltStore) GetEvents(ctx context.Context, issueID string, limit int) ([]*types.Event, error) {
-	panic("embeddeddolt: GetEvents not implemented")
-}
+// GetEvents is implemented in events.go.
 
-func (s *EmbeddedDoltStore) GetAllEventsSince(ctx context.Context, since time.Time) ([]*types.Event, error) {
-	panic("embeddeddolt: GetAllEventsSince not implemented")
-}
+// GetAllEventsSince is implemented in events.go.
 
-func (s *EmbeddedDoltStore) RunInTransaction(ctx context.Context, commitMsg string, fn func(tx storage.Transaction) error) error {
-	panic("embeddeddolt: RunInTransaction not implemented")
-}
+// RunInTransaction is implemented in transaction.go.
 
 // Close marks the store as closed. Subsequent method calls will return errClosed.
 // It is safe to call multiple times.
@@ -379,13 +338,9 @@ func (s *EmbeddedDoltStore) ResolveConflicts(ctx context.Context, table string,
 // storage.HistoryViewer
 // ---------------------------------------------------------------------------
 
-func (s *EmbeddedDoltStore) History(ctx context.Context, issueID string) ([]*storage.HistoryEntry, error) {
-	panic("embeddeddolt: History not implemented")
-}
+// History is implemented in history.go.
 
-func (s *EmbeddedDoltStore) AsOf(ctx context.Context, issueID string, ref string) (*types.Issue, error) {
-	panic("embeddeddolt: AsOf not implemented")
-}
+// AsOf is implemented in history.go.
 
 func (s *EmbeddedDoltStore) Diff(ctx context.Context, fromRef, toRef string) ([]*storage.DiffEntry, error) {
 	panic("embeddeddolt: Diff not implemented")
@@ -473,9 +428,7 @@ func (s *EmbeddedDoltStore) DeleteIssuesBySourceRepo(ctx context.Context, source
 	panic("embeddeddolt: DeleteIssuesBySourceRepo not implemented")
 }
 
-func (s *EmbeddedDoltStore) UpdateIssueID(ctx context.Context, oldID, newID string, issue *types.Issue, actor string) error {
-	panic("embeddeddolt: UpdateIssueID not implemented")
-}
+// UpdateIssueID is implemented in rename.go.
 
 func (s *EmbeddedDoltStore) ClaimIssue(ctx context.Context, id string, actor string) error {
 	panic("embeddeddolt: ClaimIssue not implemented")
@@ -508,17 +461,11 @@ func (s *EmbeddedDoltStore) GetDependencyRecords(ctx context.Context, issueID st
 	return result, err
 }
 
-func (s *EmbeddedDoltStore) IsBlocked(ctx context.Context, issueID string) (bool, []string, error) {
-	panic("embeddeddolt: IsBlocked not implemented")
-}
+// IsBlocked is implemented in blocked.go.
 
-func (s *EmbeddedDoltStore) GetNewlyUnblockedByClose(ctx context.Context, closedIssueID string) ([]*types.Issue, error) {
-	panic("embeddeddolt: GetNewlyUnblockedByClose not implemented")
-}
+// GetNewlyUnblockedByClose is implemented in blocked.go.
 
-func (s *EmbeddedDoltStore) DetectCycles(ctx context.Context) ([][]*types.Issue, error) {
-	panic("embeddeddolt: DetectCycles not implemented")
-}
+// DetectCycles is implemented in dependencies.go.
 
 func (s *EmbeddedDoltStore) FindWispDependentsRecursive(ctx context.Context, ids []string) (map[string]bool, error) {
 	panic("embeddeddolt: FindWispDependentsRecursive not implemented")
@@ -532,25 +479,17 @@ func (s *EmbeddedDoltStore) RenameDependencyPrefix(ctx context.Context, oldPrefi
 // storage.AnnotationQueryStore
 // ---------------------------------------------------------------------------
 
-func (s *EmbeddedDoltStore) AddComment(ctx context.Context, issueID, actor, comment string) error {
-	panic("embeddeddolt: AddComment not implemented")
-}
+// AddComment is implemented in comments.go.
 
-func (s *EmbeddedDoltStore) ImportIssueComment(ctx context.Context, issueID, author, text string, createdAt time.Time) (*types.Comment, error) {
-	panic("embeddeddolt: ImportIssueComment not implemented")
-}
+// ImportIssueComment is implemented in comments.go.
 
-func (s *EmbeddedDoltStore) GetCommentsForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Comment, error) {
-	panic("embeddeddolt: GetCommentsForIssues not implemented")
-}
+// GetCommentsForIssues is implemented in comments.go.
 
 // ---------------------------------------------------------------------------
 // storage.ConfigMetadataStore
 // ---------------------------------------------------------------------------
 
-func (s *EmbeddedDoltStore) DeleteConfig(ctx context.Context, key string) error {
-	panic("embeddeddolt: DeleteConfig not implemented")
-}
+// DeleteConfig is implemented in config_metadata.go.
 
 func (s *EmbeddedDoltStore) GetCustomStatuses(ctx context.Context) ([]string, error) {
 	var result []string
@@ -614,14 +553,10 @@ func (s *EmbeddedDoltStore) ClearRepoMtime(ctx context.Context, repoPath string)
 	panic("embeddeddolt: ClearRepoMtime not implemented")
 }
 
-func (s *EmbeddedDoltStore) GetMoleculeProgress(ctx context.Context, moleculeID string) (*types.MoleculeProgressStats, error) {
-	panic("embeddeddolt: GetMoleculeProgress not implemented")
-}
+// GetMoleculeProgress is implemented in molecules.go.
 
 func (s *EmbeddedDoltStore) GetMoleculeLastActivity(ctx context.Context, moleculeID string) (*types.MoleculeLastActivity, error) {
 	panic("embeddeddolt: GetMoleculeLastActivity not implemented")
 }
 
-func (s *EmbeddedDoltStore) GetStaleIssues(ctx context.Context, filter types.StaleFilter) ([]*types.Issue, error) {
-	panic("embeddeddolt: GetStaleIssues not implemented")
-}
+// GetStaleIssues is implemented in list_queries.go.
@@ -0,0 +1,144 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// embeddedTransaction implements storage.Transaction on a single SQL
+// transaction. Nothing it writes is visible outside the transaction until
+// RunInTransaction commits, and everything is rolled back if fn fails.
+type embeddedTransaction struct {
+	tx *sql.Tx
+}
+
+// RunInTransaction executes fn within one SQL transaction. If fn returns an
+// error (or panics) every write is rolled back. On success the transaction is
+// committed and, when commitMsg is non-empty, a Dolt commit is created in the
+// same transaction. "Nothing to commit" is benign (all writes went to
+// dolt-ignored wisp tables).
+func (s *EmbeddedDoltStore) RunInTransaction(ctx context.Context, commitMsg string, fn func(tx storage.Transaction) error) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		if err := fn(&embeddedTransaction{tx: tx}); err != nil {
+			return err
+		}
+		if commitMsg == "" {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx, "CALL DOLT_ADD('-A')"); err != nil {
+			return fmt.Errorf("dolt add: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?)", commitMsg); err != nil && !issueops.IsDoltNothingToCommit(err) {
+			return fmt.Errorf("dolt commit: %w", err)
+		}
+		return nil
+	})
+}
+
+func (t *embeddedTransaction) CreateIssue(ctx context.Context, issue *types.Issue, actor string) error {
+	if issue == nil {
+		return fmt.Errorf("issue must not be nil")
+	}
+	bc, err := issueops.NewBatchContext(ctx, t.tx, storage.BatchCreateOptions{
+		SkipPrefixValidation: true,
+	})
+	if err != nil {
+		return err
+	}
+	return issueops.CreateIssueInTx(ctx, t.tx, bc, issue, actor)
+}
+
+func (t *embeddedTransaction) CreateIssues(ctx context.Context, issues []*types.Issue, actor string) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	return issueops.CreateIssuesInTx(ctx, t.tx, issues, actor, storage.BatchCreateOptions{
+		OrphanHandling: storage.OrphanAllow,
+	})
+}
+
+func (t *embeddedTransaction) UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error {
+	return updateIssueInTx(ctx, t.tx, id, updates, actor)
+}
+
+func (t *embeddedTransaction) CloseIssue(ctx context.Context, id string, reason string, actor string, session string) error {
+	return issueops.CloseIssueInTx(ctx, t.tx, id, reason, actor, session)
+}
+
+func (t *embeddedTransaction) DeleteIssue(ctx context.Context, id string) error {
+	return issueops.DeleteIssueInTx(ctx, t.tx, id)
+}
+
+func (t *embeddedTransaction) GetIssue(ctx context.Context, id string) (*types.Issue, error) {
+	return issueops.GetIssueInTx(ctx, t.tx, id)
+}
+
+func (t *embeddedTransaction) SearchIssues(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error) {
+	return issueops.SearchIssuesInTx(ctx, t.tx, query, filter)
+}
+
+func (t *embeddedTransaction) AddDependency(ctx context.Context, dep *types.Dependency, actor string) error {
+	return issueops.AddDependencyInTx(ctx, t.tx, dep, actor, issueops.AddDependencyOpts{
+		IsCrossPrefix: types.ExtractPrefix(dep.IssueID) != types.ExtractPrefix(dep.DependsOnID),
+	})
+}
+
+func (t *embeddedTransaction) RemoveDependency(ctx context.Context, issueID, dependsOnID string, actor string) error {
+	return issueops.RemoveDependencyInTx(ctx, t.tx, issueID, dependsOnID, actor)
+}
+
+func (t *embeddedTransaction) GetDependencyRecords(ctx context.Context, issueID string) ([]*types.Dependency, error) {
+	deps, err := issueops.GetDependencyRecordsForIssuesInTx(ctx, t.tx, []string{issueID})
+	if err != nil {
+		return nil, err
+	}
+	return deps[issueID], nil
+}
+
+func (t *embeddedTransaction) AddLabel(ctx context.Context, issueID, label, actor string) error {
+	return issueops.AddLabelInTx(ctx, t.tx, "", "", issueID, label, actor)
+}
+
+func (t *embeddedTransaction) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
+	return issueops.RemoveLabelInTx(ctx, t.tx, issueID, label, actor)
+}
+
+func (t *embeddedTransaction) GetLabels(ctx context.Context, issueID string) ([]string, error) {
+	return issueops.GetLabelsInTx(ctx, t.tx, "", issueID)
+}
+
+func (t *embeddedTransaction) SetConfig(ctx context.Context, key, value string) error {
+	return issueops.SetConfigInTx(ctx, t.tx, key, value)
+}
+
+func (t *embeddedTransaction) GetConfig(ctx context.Context, key string) (string, error) {
+	return issueops.GetConfigInTx(ctx, t.tx, key)
+}
+
+func (t *embeddedTransaction) SetMetadata(ctx context.Context, key, value string) error {
+	return issueops.SetMetadataInTx(ctx, t.tx, key, value)
+}
+
+func (t *embeddedTransaction) GetMetadata(ctx context.Context, key string) (string, error) {
+	return issueops.GetMetadataInTx(ctx, t.tx, key)
+}
+
+func (t *embeddedTransaction) AddComment(ctx context.Context, issueID, actor, comment string) error {
+	return issueops.AddCommentEventInTx(ctx, t.tx, issueID, actor, comment)
+}
+
+func (t *embeddedTransaction) ImportIssueComment(ctx context.Context, issueID, author, text string, createdAt time.Time) (*types.Comment, error) {
+	return issueops.ImportIssueCommentInTx(ctx, t.tx, issueID, author, text, createdAt)
+}
+
+func (t *embeddedTransaction) GetIssueComments(ctx context.Context, issueID string) ([]*types.Comment, error) {
+	return issueops.GetIssueCommentsInTx(ctx, t.tx, issueID)
+}
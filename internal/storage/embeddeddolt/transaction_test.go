@@ -0,0 +1,152 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestRunInTransaction(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("commit", func(t *testing.T) {
+		te := newTestEnv(t, "tc")
+		ctx := t.Context()
+		before := te.headHash(t)
+
+		err := te.store.RunInTransaction(ctx, "create parent and child", func(tx storage.Transaction) error {
+			for _, id := range []string{"tc-parent", "tc-child"} {
+				issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+				if err := tx.CreateIssue(ctx, issue, "tester"); err != nil {
+					return err
+				}
+			}
+			if err := tx.AddDependency(ctx, &types.Dependency{IssueID: "tc-child", DependsOnID: "tc-parent", Type: types.DepParentChild}, "tester"); err != nil {
+				return err
+			}
+			if err := tx.AddLabel(ctx, "tc-child", "backend", "tester"); err != nil {
+				return err
+			}
+			// Reads inside the transaction see its own writes.
+			got, err := tx.GetIssue(ctx, "tc-child")
+			if err != nil {
+				return err
+			}
+			if len(got.Labels) != 1 {
+				t.Errorf("read-your-writes labels: got %v, want [backend]", got.Labels)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("RunInTransaction: %v", err)
+		}
+
+		te.assertRowExists(t, ctx, "issues", "tc-parent")
+		te.assertRowExists(t, ctx, "issues", "tc-child")
+		te.assertLabelCount(t, ctx, "labels", "tc-child", 1)
+		if after := te.headHash(t); after == before {
+			t.Error("expected a new Dolt commit after RunInTransaction with a message")
+		}
+	})
+
+	t.Run("rollback_on_error", func(t *testing.T) {
+		te := newTestEnv(t, "tr")
+		ctx := t.Context()
+
+		errAbort := errors.New("abort")
+		err := te.store.RunInTransaction(ctx, "should not land", func(tx storage.Transaction) error {
+			for _, id := range []string{"tr-a", "tr-b"} {
+				issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+				if err := tx.CreateIssue(ctx, issue, "tester"); err != nil {
+					return err
+				}
+			}
+			if err := tx.SetConfig(ctx, "tr.key", "value"); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		if !errors.Is(err, errAbort) {
+			t.Fatalf("RunInTransaction: got %v, want %v", err, errAbort)
+		}
+
+		te.assertRowNotExists(t, ctx, "issues", "tr-a")
+		te.assertRowNotExists(t, ctx, "issues", "tr-b")
+		if value, err := te.store.GetConfig(ctx, "tr.key"); err != nil || value != "" {
+			t.Errorf("GetConfig(tr.key) after rollback: got %q, %v; want empty", value, err)
+		}
+	})
+
+	t.Run("wisp_only", func(t *testing.T) {
+		te := newTestEnv(t, "tw")
+		ctx := t.Context()
+
+		err := te.store.RunInTransaction(ctx, "wisp only", func(tx storage.Transaction) error {
+			wisp := &types.Issue{ID: "tw-wisp", Title: "wisp", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true}
+			return tx.CreateIssue(ctx, wisp, "tester")
+		})
+		if err != nil {
+			t.Fatalf("RunInTransaction: %v", err)
+		}
+		te.assertRowExists(t, ctx, "wisps", "tw-wisp")
+	})
+
+	t.Run("writes_match_store", func(t *testing.T) {
+		te := newTestEnv(t, "tm")
+		ctx := t.Context()
+
+		for _, id := range []string{"tm-a", "tm-b", "tm-gone"} {
+			issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("CreateIssue %s: %v", id, err)
+			}
+		}
+		for _, dep := range []*types.Dependency{
+			{IssueID: "tm-a", DependsOnID: "tm-b", Type: types.DepBlocks},
+			{IssueID: "tm-b", DependsOnID: "tm-gone", Type: types.DepBlocks},
+		} {
+			if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+				t.Fatalf("AddDependency %s->%s: %v", dep.IssueID, dep.DependsOnID, err)
+			}
+		}
+		if err := te.store.AddLabel(ctx, "tm-gone", "backend", "tester"); err != nil {
+			t.Fatalf("AddLabel: %v", err)
+		}
+
+		err := te.store.RunInTransaction(ctx, "close, unlink and delete", func(tx storage.Transaction) error {
+			if err := tx.CloseIssue(ctx, "tm-a", "done", "tester", ""); err != nil {
+				return err
+			}
+			if err := tx.RemoveDependency(ctx, "tm-a", "tm-b", "tester"); err != nil {
+				return err
+			}
+			if err := tx.DeleteIssue(ctx, "tm-gone"); err != nil {
+				return err
+			}
+			if err := tx.CloseIssue(ctx, "tm-missing", "done", "tester", ""); !errors.Is(err, storage.ErrNotFound) {
+				t.Errorf("CloseIssue missing: expected ErrNotFound, got %v", err)
+			}
+			if err := tx.DeleteIssue(ctx, "tm-missing"); !errors.Is(err, storage.ErrNotFound) {
+				t.Errorf("DeleteIssue missing: expected ErrNotFound, got %v", err)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("RunInTransaction: %v", err)
+		}
+
+		te.assertEventCount(t, ctx, "events", "tm-a", string(types.EventClosed), 1)
+		te.assertEventCount(t, ctx, "events", "tm-a", string(types.EventDependencyRemoved), 1)
+		te.assertRowNotExists(t, ctx, "issues", "tm-gone")
+		te.assertLabelCount(t, ctx, "labels", "tm-gone", 0)
+		var count int
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM dependencies WHERE depends_on_id = ?", []any{"tm-gone"}, &count)
+		if count != 0 {
+			t.Errorf("dependency rows pointing at deleted issue: got %d, want 0", count)
+		}
+	})
+}
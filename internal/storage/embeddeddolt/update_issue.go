@@ -0,0 +1,31 @@
+//go:build embeddeddolt
+
+package embeddeddolt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+)
+
+func (s *EmbeddedDoltStore) UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error {
+	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+		return updateIssueInTx(ctx, tx, id, updates, actor)
+	})
+}
+
+// updateIssueInTx is shared by UpdateIssue and embeddedTransaction.UpdateIssue.
+func updateIssueInTx(ctx context.Context, tx *sql.Tx, id string, updates map[string]interface{}, actor string) error {
+	// DoltStore demotes a regular issue to the wisps table when it gains
+	// no_history or wisp; that migration is not implemented here yet.
+	if !issueops.IsActiveWispInTx(ctx, tx, id) {
+		for _, key := range []string{"no_history", "wisp"} {
+			if _, ok := updates[key]; ok {
+				return fmt.Errorf("embeddeddolt: updating %s on a non-wisp issue is not supported", key)
+			}
+		}
+	}
+	return issueops.UpdateIssueInTx(ctx, tx, id, updates, actor)
+}
@@ -0,0 +1,167 @@
+//go:build embeddeddolt
+
+package embeddeddolt_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestUpdateIssue(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+
+	t.Run("status_change_moves_issue", func(t *testing.T) {
+		te := newTestEnv(t, "up")
+		ctx := t.Context()
+
+		issue := &types.Issue{ID: "up-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+		if err := te.store.UpdateIssue(ctx, "up-a", map[string]interface{}{"status": string(types.StatusInProgress)}, "tester"); err != nil {
+			t.Fatalf("UpdateIssue: %v", err)
+		}
+
+		open := types.StatusOpen
+		results, err := te.store.SearchIssues(ctx, "", types.IssueFilter{Status: &open})
+		if err != nil {
+			t.Fatalf("SearchIssues(open): %v", err)
+		}
+		for _, r := range results {
+			if r.ID == "up-a" {
+				t.Error("up-a still listed under old status open")
+			}
+		}
+
+		inProgress := types.StatusInProgress
+		results, err = te.store.SearchIssues(ctx, "", types.IssueFilter{Status: &inProgress})
+		if err != nil {
+			t.Fatalf("SearchIssues(in_progress): %v", err)
+		}
+		if len(results) != 1 || results[0].ID != "up-a" {
+			t.Errorf("expected up-a under in_progress, got %d results", len(results))
+		}
+
+		var events int
+		te.queryScalar(t, ctx, "SELECT COUNT(*) FROM events WHERE issue_id = ? AND event_type = ?",
+			[]any{"up-a", string(types.EventStatusChanged)}, &events)
+		if events != 1 {
+			t.Errorf("status_changed events: got %d, want 1", events)
+		}
+	})
+
+	t.Run("fields_and_closed_at", func(t *testing.T) {
+		te := newTestEnv(t, "uf")
+		ctx := t.Context()
+
+		issue := &types.Issue{ID: "uf-a", Title: "Before", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+		if err := te.store.UpdateIssue(ctx, "uf-a", map[string]interface{}{
+			"title":    "After",
+			"priority": 0,
+			"assignee": "alice",
+			"status":   string(types.StatusClosed),
+		}, "tester"); err != nil {
+			t.Fatalf("UpdateIssue: %v", err)
+		}
+		got, err := te.store.GetIssue(ctx, "uf-a")
+		if err != nil {
+			t.Fatalf("GetIssue: %v", err)
+		}
+		if got.Title != "After" || got.Priority != 0 || got.Assignee != "alice" {
+			t.Errorf("fields not updated: title=%q priority=%d assignee=%q", got.Title, got.Priority, got.Assignee)
+		}
+		if got.Status != types.StatusClosed || got.ClosedAt == nil {
+			t.Errorf("expected closed with closed_at, got status=%s closed_at=%v", got.Status, got.ClosedAt)
+		}
+
+		// Reopening clears closed_at.
+		if err := te.store.UpdateIssue(ctx, "uf-a", map[string]interface{}{"status": string(types.StatusOpen)}, "tester"); err != nil {
+			t.Fatalf("UpdateIssue(reopen): %v", err)
+		}
+		got, err = te.store.GetIssue(ctx, "uf-a")
+		if err != nil {
+			t.Fatalf("GetIssue: %v", err)
+		}
+		if got.ClosedAt != nil {
+			t.Errorf("closed_at not cleared on reopen: %v", got.ClosedAt)
+		}
+	})
+
+	t.Run("reassign_records_event", func(t *testing.T) {
+		te := newTestEnv(t, "ua")
+		ctx := t.Context()
+
+		issue := &types.Issue{ID: "ua-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Assignee: "alice"}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+		if err := te.store.UpdateIssue(ctx, "ua-a", map[string]interface{}{"assignee": "bob"}, "tester"); err != nil {
+			t.Fatalf("UpdateIssue: %v", err)
+		}
+
+		for _, tc := range []struct {
+			assignee string
+			want     int
+		}{{"alice", 0}, {"bob", 1}} {
+			assignee := tc.assignee
+			results, err := te.store.SearchIssues(ctx, "", types.IssueFilter{Assignee: &assignee})
+			if err != nil {
+				t.Fatalf("SearchIssues(%s): %v", assignee, err)
+			}
+			if len(results) != tc.want {
+				t.Errorf("SearchIssues(%s): got %d results, want %d", assignee, len(results), tc.want)
+			}
+		}
+		results, err := te.store.SearchIssues(ctx, "", types.IssueFilter{NoAssignee: true})
+		if err != nil {
+			t.Fatalf("SearchIssues(unassigned): %v", err)
+		}
+		if len(results) != 0 {
+			t.Errorf("SearchIssues(unassigned): got %d results, want 0", len(results))
+		}
+
+		var oldValue, newValue string
+		te.queryScalar(t, ctx, "SELECT old_value FROM events WHERE issue_id = ? AND event_type = ?",
+			[]any{"ua-a", string(types.EventAssigneeChanged)}, &oldValue)
+		te.queryScalar(t, ctx, "SELECT new_value FROM events WHERE issue_id = ? AND event_type = ?",
+			[]any{"ua-a", string(types.EventAssigneeChanged)}, &newValue)
+		if oldValue != "alice" || newValue != "bob" {
+			t.Errorf("assignee_changed event: got %q -> %q, want alice -> bob", oldValue, newValue)
+		}
+
+		// Setting the same assignee again is not a reassignment.
+		if err := te.store.UpdateIssue(ctx, "ua-a", map[string]interface{}{"assignee": "bob"}, "tester"); err != nil {
+			t.Fatalf("UpdateIssue (same assignee): %v", err)
+		}
+		te.assertEventCount(t, ctx, "events", "ua-a", string(types.EventAssigneeChanged), 1)
+	})
+
+	t.Run("invalid_field", func(t *testing.T) {
+		te := newTestEnv(t, "ui")
+		ctx := t.Context()
+
+		issue := &types.Issue{ID: "ui-a", Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+		if err := te.store.UpdateIssue(ctx, "ui-a", map[string]interface{}{"id": "ui-b"}, "tester"); err == nil {
+			t.Error("expected error for disallowed field")
+		}
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		te := newTestEnv(t, "un")
+		ctx := t.Context()
+
+		err := te.store.UpdateIssue(ctx, "un-missing", map[string]interface{}{"title": "x"}, "tester")
+		if !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// CloseIssueInTx marks an issue closed within an existing transaction and
+// records a closed event carrying the reason. Routes to the wisps and
+// wisp_events tables if the ID is an active wisp. Returns storage.ErrNotFound
+// (wrapped) if the issue does not exist.
+func CloseIssueInTx(ctx context.Context, tx *sql.Tx, id, reason, actor, session string) error {
+	issueTable, _, eventTable, _ := WispTableRouting(IsActiveWispInTx(ctx, tx, id))
+
+	now := time.Now().UTC()
+	//nolint:gosec // G201: issueTable is from WispTableRouting
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?, closed_by_session = ?
+		WHERE id = ?
+	`, issueTable), types.StatusClosed, now, now, reason, session, id)
+	if err != nil {
+		return fmt.Errorf("close issue: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("close issue: rows affected: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: issue %s", storage.ErrNotFound, id)
+	}
+
+	if err := RecordEventInTable(ctx, tx, eventTable, id, types.EventClosed, actor, reason); err != nil {
+		return fmt.Errorf("close issue: record event: %w", err)
+	}
+	return nil
+}
+
+// CloseIssuesInTx closes every issue in ids within an existing transaction,
+// recording a closed event for each, and returns the IDs it actually closed.
+// Issues that are already closed are skipped. If any ID does not exist,
+// nothing is closed and a *storage.MissingIssuesError listing them is
+// returned; the caller must roll back so the batch stays all-or-nothing.
+func CloseIssuesInTx(ctx context.Context, tx *sql.Tx, ids []string, reason, actor, session string) ([]string, error) {
+	var missing, toClose []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		issueTable, _, _, _ := WispTableRouting(IsActiveWispInTx(ctx, tx, id))
+		var status string
+		//nolint:gosec // G201: issueTable is from WispTableRouting
+		err := tx.QueryRowContext(ctx, fmt.Sprintf(`SELECT status FROM %s WHERE id = ?`, issueTable), id).Scan(&status)
+		if errors.Is(err, sql.ErrNoRows) {
+			missing = append(missing, id)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("close issues: get %s: %w", id, err)
+		}
+		if status != string(types.StatusClosed) {
+			toClose = append(toClose, id)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, &storage.MissingIssuesError{IDs: missing}
+	}
+
+	for _, id := range toClose {
+		if err := CloseIssueInTx(ctx, tx, id, reason, actor, session); err != nil {
+			return nil, err
+		}
+	}
+	return toClose, nil
+}
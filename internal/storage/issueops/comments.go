@@ -5,8 +5,165 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/steveyegge/beads/internal/types"
 )
 
+// AddCommentEventInTx records a "commented" event on an issue within a
+// transaction. Routes to wisp_events for active wisps.
+func AddCommentEventInTx(ctx context.Context, tx *sql.Tx, issueID, actor, comment string) error {
+	_, _, eventTable, _ := WispTableRouting(IsActiveWispInTx(ctx, tx, issueID))
+	//nolint:gosec // G201: eventTable is from WispTableRouting
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO %s (issue_id, event_type, actor, comment)
+		VALUES (?, ?, ?, ?)
+	`, eventTable), issueID, types.EventCommented, actor, comment)
+	if err != nil {
+		return fmt.Errorf("add comment: %w", err)
+	}
+	return nil
+}
+
+// ImportIssueCommentInTx adds a structured comment to an issue within a
+// transaction, preserving the given timestamp. Routes to wisp_comments for
+// active wisps. Returns an error if the issue does not exist.
+func ImportIssueCommentInTx(ctx context.Context, tx *sql.Tx, issueID, author, text string, createdAt time.Time) (*types.Comment, error) {
+	isWisp := IsActiveWispInTx(ctx, tx, issueID)
+	issueTable, _, _, _ := WispTableRouting(isWisp)
+	commentTable := "comments"
+	if isWisp {
+		commentTable = "wisp_comments"
+	}
+
+	var exists bool
+	//nolint:gosec // G201: issueTable is from WispTableRouting
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf(
+		`SELECT EXISTS(SELECT 1 FROM %s WHERE id = ?)`, issueTable), issueID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("add comment: check issue existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("issue %s not found", issueID)
+	}
+
+	createdAt = createdAt.UTC()
+	id := uuid.Must(uuid.NewV7()).String()
+	//nolint:gosec // G201: commentTable is hardcoded
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, issue_id, author, text, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, commentTable), id, issueID, author, text, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("add comment: %w", err)
+	}
+
+	return &types.Comment{
+		ID:        id,
+		IssueID:   issueID,
+		Author:    author,
+		Text:      text,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// GetIssueCommentsInTx returns the comments on an issue, oldest first.
+// Routes to wisp_comments for active wisps.
+func GetIssueCommentsInTx(ctx context.Context, tx *sql.Tx, issueID string) ([]*types.Comment, error) {
+	table := "comments"
+	if IsActiveWispInTx(ctx, tx, issueID) {
+		table = "wisp_comments"
+	}
+
+	//nolint:gosec // G201: table is hardcoded
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT id, issue_id, author, text, created_at
+		FROM %s
+		WHERE issue_id = ?
+		ORDER BY created_at ASC, id ASC
+	`, table), issueID)
+	if err != nil {
+		return nil, fmt.Errorf("get comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*types.Comment
+	for rows.Next() {
+		var c types.Comment
+		if err := rows.Scan(&c.ID, &c.IssueID, &c.Author, &c.Text, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("get comments: scan: %w", err)
+		}
+		comments = append(comments, &c)
+	}
+	return comments, rows.Err()
+}
+
+// GetCommentsForIssuesInTx returns comments for multiple issues keyed by
+// issue ID, each list oldest first. Routes each ID to comments or
+// wisp_comments based on wisp status.
+// Uses batched IN clauses (queryBatchSize) to avoid query-planner spikes.
+func GetCommentsForIssuesInTx(ctx context.Context, tx *sql.Tx, issueIDs []string) (map[string][]*types.Comment, error) {
+	result := make(map[string][]*types.Comment)
+	if len(issueIDs) == 0 {
+		return result, nil
+	}
+
+	var wispIDs, permIDs []string
+	for _, id := range issueIDs {
+		if IsActiveWispInTx(ctx, tx, id) {
+			wispIDs = append(wispIDs, id)
+		} else {
+			permIDs = append(permIDs, id)
+		}
+	}
+
+	for _, pair := range []struct {
+		table string
+		ids   []string
+	}{
+		{"wisp_comments", wispIDs},
+		{"comments", permIDs},
+	} {
+		for start := 0; start < len(pair.ids); start += queryBatchSize {
+			end := start + queryBatchSize
+			if end > len(pair.ids) {
+				end = len(pair.ids)
+			}
+			batch := pair.ids[start:end]
+			placeholders := make([]string, len(batch))
+			args := make([]any, len(batch))
+			for i, id := range batch {
+				placeholders[i] = "?"
+				args[i] = id
+			}
+			//nolint:gosec // G201: pair.table is hardcoded
+			rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+				SELECT id, issue_id, author, text, created_at
+				FROM %s
+				WHERE issue_id IN (%s)
+				ORDER BY issue_id, created_at ASC, id ASC
+			`, pair.table, strings.Join(placeholders, ",")), args...)
+			if err != nil {
+				return nil, fmt.Errorf("get comments for issues from %s: %w", pair.table, err)
+			}
+			for rows.Next() {
+				var c types.Comment
+				if err := rows.Scan(&c.ID, &c.IssueID, &c.Author, &c.Text, &c.CreatedAt); err != nil {
+					_ = rows.Close()
+					return nil, fmt.Errorf("get comments for issues: scan: %w", err)
+				}
+				result[c.IssueID] = append(result[c.IssueID], &c)
+			}
+			_ = rows.Close()
+			if err := rows.Err(); err != nil {
+				return nil, fmt.Errorf("get comments for issues: rows: %w", err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // GetCommentCountsInTx returns comment counts per issue ID within a transaction.
 // Routes each ID to comments or wisp_comments based on wisp status.
 // Uses batched IN clauses (queryBatchSize) to avoid query-planner spikes.
@@ -34,6 +34,15 @@ func GetConfigInTx(ctx context.Context, tx *sql.Tx, key string) (string, error)
 	return value, nil
 }
 
+// DeleteConfigInTx removes a configuration value within an existing transaction.
+// Deleting a key that does not exist is not an error.
+func DeleteConfigInTx(ctx context.Context, tx *sql.Tx, key string) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM config WHERE `key` = ?", key); err != nil {
+		return fmt.Errorf("delete config %s: %w", key, err)
+	}
+	return nil
+}
+
 // GetAllConfigInTx retrieves all configuration key-value pairs within an existing transaction.
 func GetAllConfigInTx(ctx context.Context, tx *sql.Tx) (map[string]string, error) {
 	rows, err := tx.QueryContext(ctx, "SELECT `key`, value FROM config")
@@ -75,3 +84,32 @@ func GetMetadataInTx(ctx context.Context, tx *sql.Tx, key string) (string, error
 	}
 	return value, nil
 }
+
+// DeleteMetadataInTx removes a metadata value within an existing transaction.
+// Deleting a key that does not exist is not an error.
+func DeleteMetadataInTx(ctx context.Context, tx *sql.Tx, key string) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM metadata WHERE `key` = ?", key); err != nil {
+		return fmt.Errorf("delete metadata %s: %w", key, err)
+	}
+	return nil
+}
+
+// GetAllMetadataInTx retrieves all metadata key-value pairs within an existing
+// transaction. Metadata lives in its own table, so config keys never appear here.
+func GetAllMetadataInTx(ctx context.Context, tx *sql.Tx) (map[string]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT `key`, value FROM metadata")
+	if err != nil {
+		return nil, fmt.Errorf("get all metadata: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]string)
+	for rows.Next() {
+		var k, v string
+		if err := rows.Scan(&k, &v); err != nil {
+			return nil, fmt.Errorf("get all metadata: scan: %w", err)
+		}
+		result[k] = v
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,194 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// DetectCyclesInTx finds circular blocking dependencies across both the
+// dependencies and wisp_dependencies tables (bd-xe27). Each cycle is returned
+// as the issues along the cycle path, in traversal order.
+//
+// The traversal is an iterative DFS with an explicit stack, so very deep
+// dependency chains cannot overflow the goroutine stack. Start nodes and
+// neighbors are visited in sorted order, making the result deterministic.
+func DetectCyclesInTx(ctx context.Context, tx *sql.Tx) ([][]*types.Issue, error) {
+	graph := make(map[string][]string)
+	for _, depTable := range []string{"dependencies", "wisp_dependencies"} {
+		//nolint:gosec // G201: depTable is hardcoded
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf(
+			`SELECT issue_id, depends_on_id FROM %s WHERE type = 'blocks'`, depTable))
+		if err != nil {
+			if isTableNotExistError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("detect cycles: deps from %s: %w", depTable, err)
+		}
+		for rows.Next() {
+			var issueID, dependsOnID string
+			if err := rows.Scan(&issueID, &dependsOnID); err != nil {
+				_ = rows.Close()
+				return nil, fmt.Errorf("detect cycles: scan: %w", err)
+			}
+			graph[issueID] = append(graph[issueID], dependsOnID)
+		}
+		_ = rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("detect cycles: rows from %s: %w", depTable, err)
+		}
+	}
+
+	nodes := make([]string, 0, len(graph))
+	for node, neighbors := range graph {
+		sort.Strings(neighbors)
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	cyclePaths := findCyclePaths(nodes, graph)
+	if len(cyclePaths) == 0 {
+		return nil, nil
+	}
+
+	// Load every issue that appears in a cycle with a single batch query.
+	seen := make(map[string]bool)
+	var ids []string
+	for _, path := range cyclePaths {
+		for _, id := range path {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	issues, err := GetIssuesByIDsInTx(ctx, tx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("detect cycles: %w", err)
+	}
+	issueByID := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		issueByID[issue.ID] = issue
+	}
+
+	var cycles [][]*types.Issue
+	for _, path := range cyclePaths {
+		var cycleIssues []*types.Issue
+		for _, id := range path {
+			if issue, ok := issueByID[id]; ok {
+				cycleIssues = append(cycleIssues, issue)
+			}
+		}
+		if len(cycleIssues) > 0 {
+			cycles = append(cycles, cycleIssues)
+		}
+	}
+	return cycles, nil
+}
+
+// findCyclePaths runs an iterative DFS over graph starting from each of nodes
+// in order, and returns the ID path of every back edge it finds.
+func findCyclePaths(nodes []string, graph map[string][]string) [][]string {
+	type frame struct {
+		node string
+		next int // index of the next neighbor to visit
+	}
+
+	var cycles [][]string
+	visited := make(map[string]bool)
+	// onStack maps each node on the current DFS path to its position in stack.
+	onStack := make(map[string]int)
+
+	for _, start := range nodes {
+		if visited[start] {
+			continue
+		}
+		visited[start] = true
+		onStack[start] = 0
+		stack := []frame{{node: start}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			neighbors := graph[top.node]
+			if top.next >= len(neighbors) {
+				delete(onStack, top.node)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			neighbor := neighbors[top.next]
+			top.next++
+
+			if pos, ok := onStack[neighbor]; ok {
+				path := make([]string, 0, len(stack)-pos)
+				for _, f := range stack[pos:] {
+					path = append(path, f.node)
+				}
+				cycles = append(cycles, path)
+				continue
+			}
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				onStack[neighbor] = len(stack)
+				stack = append(stack, frame{node: neighbor})
+			}
+		}
+	}
+	return cycles
+}
+
+// FindBlockingPathInTx returns the shortest chain of blocking dependencies
+// leading from one issue to another, starting with from and ending with to,
+// or nil if to is not reachable. Edges are read from each of depTables.
+func FindBlockingPathInTx(ctx context.Context, tx *sql.Tx, from, to string, depTables []string) ([]string, error) {
+	prev := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node == to {
+			var path []string
+			for n := to; n != ""; n = prev[n] {
+				path = append(path, n)
+			}
+			slices.Reverse(path)
+			return path, nil
+		}
+
+		var neighbors []string
+		for _, depTable := range depTables {
+			//nolint:gosec // G201: depTable is caller-controlled constant
+			rows, err := tx.QueryContext(ctx, fmt.Sprintf(
+				`SELECT depends_on_id FROM %s WHERE issue_id = ? AND type = 'blocks'`, depTable), node)
+			if err != nil {
+				if isTableNotExistError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("find blocking path: deps from %s: %w", depTable, err)
+			}
+			for rows.Next() {
+				var dependsOnID string
+				if err := rows.Scan(&dependsOnID); err != nil {
+					_ = rows.Close()
+					return nil, fmt.Errorf("find blocking path: scan: %w", err)
+				}
+				neighbors = append(neighbors, dependsOnID)
+			}
+			_ = rows.Close()
+			if err := rows.Err(); err != nil {
+				return nil, fmt.Errorf("find blocking path: rows from %s: %w", depTable, err)
+			}
+		}
+		sort.Strings(neighbors)
+		for _, next := range neighbors {
+			if _, seen := prev[next]; !seen {
+				prev[next] = node
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil, nil
+}
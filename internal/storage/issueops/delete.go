@@ -0,0 +1,74 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/storage"
+)
+
+// DeleteIssueInTx permanently removes an issue within an existing transaction,
+// together with its dependency edges (in both directions), events, comments,
+// and labels. Routes to the wisp_* tables if the ID is an active wisp. Edges
+// pointing at the issue are also removed from the other dependency table,
+// since wisps and permanent issues can depend on each other.
+// Returns storage.ErrNotFound (wrapped) if the issue does not exist.
+//
+// The related rows are removed explicitly rather than relying on foreign key
+// cascades, so no label or dependency row can outlive its issue and keep it
+// visible to label-, parent-, or blocker-filtered queries.
+func DeleteIssueInTx(ctx context.Context, tx *sql.Tx, id string) error {
+	isWisp := IsActiveWispInTx(ctx, tx, id)
+	issueTable, labelTable, eventTable, depTable := WispTableRouting(isWisp)
+	commentTable := "comments"
+	if isWisp {
+		commentTable = "wisp_comments"
+	}
+
+	// Two targeted deletes instead of an OR so each side uses its own index.
+	for _, col := range []string{"issue_id", "depends_on_id"} {
+		//nolint:gosec // G201: depTable is from WispTableRouting, col is hardcoded
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s = ?", depTable, col), id); err != nil {
+			return fmt.Errorf("delete issue: %s (%s): %w", depTable, col, err)
+		}
+	}
+
+	// Skip when the ID also lives in the other issue table, as it does
+	// mid-promotion: those edges belong to the surviving copy.
+	otherIssueTable, _, _, otherDepTable := WispTableRouting(!isWisp)
+	var exists int
+	//nolint:gosec // G201: otherIssueTable is from WispTableRouting
+	err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT 1 FROM %s WHERE id = ? LIMIT 1", otherIssueTable), id).Scan(&exists)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		//nolint:gosec // G201: otherDepTable is from WispTableRouting
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE depends_on_id = ?", otherDepTable), id); err != nil && !isTableNotExistError(err) {
+			return fmt.Errorf("delete issue: %s (depends_on_id): %w", otherDepTable, err)
+		}
+	case err != nil && !isTableNotExistError(err):
+		return fmt.Errorf("delete issue: check %s: %w", otherIssueTable, err)
+	}
+
+	for _, table := range []string{eventTable, commentTable, labelTable} {
+		//nolint:gosec // G201: table is from WispTableRouting or hardcoded
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE issue_id = ?", table), id); err != nil {
+			return fmt.Errorf("delete issue: %s: %w", table, err)
+		}
+	}
+
+	//nolint:gosec // G201: issueTable is from WispTableRouting
+	result, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = ?", issueTable), id)
+	if err != nil {
+		return fmt.Errorf("delete issue: %s: %w", issueTable, err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete issue: rows affected: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: issue %s", storage.ErrNotFound, id)
+	}
+	return nil
+}
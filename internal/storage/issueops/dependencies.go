@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -38,9 +39,12 @@ type AddDependencyOpts struct {
 //   - Wisp routing (auto-detected or caller-provided)
 //   - Source/target existence validation
 //   - Cross-type blocking validation (GH#1495)
-//   - Cycle detection via recursive CTE across both dependency tables
+//   - Cycle detection via recursive CTE across both dependency tables,
+//     returning storage.ErrWouldCreateCycle; only blocks edges are checked,
+//     so non-blocking types such as related may form loops
 //   - Idempotent same-type updates (metadata only)
 //   - Type conflict detection
+//   - Recording a dependency_added event on the source issue
 //
 // The caller is responsible for transaction lifecycle, dolt commits, and
 // any cache invalidation.
@@ -140,7 +144,7 @@ func AddDependencyInTx(ctx context.Context, tx *sql.Tx, dep *types.Dependency, a
 			return fmt.Errorf("failed to check for dependency cycle: %w", err)
 		}
 		if reachable > 0 {
-			return fmt.Errorf("adding dependency would create a cycle")
+			return WouldCreateCycleError(ctx, tx, dep, depTables)
 		}
 	}
 
@@ -172,5 +176,63 @@ func AddDependencyInTx(ctx context.Context, tx *sql.Tx, dep *types.Dependency, a
 	`, writeTable), dep.IssueID, dep.DependsOnID, dep.Type, actor, metadata, dep.ThreadID); err != nil {
 		return fmt.Errorf("failed to add dependency: %w", err)
 	}
+
+	eventTable := "events"
+	if writeTable == "wisp_dependencies" {
+		eventTable = "wisp_events"
+	}
+	return RecordDependencyAddedInTx(ctx, tx, eventTable, dep, actor)
+}
+
+// RecordDependencyAddedInTx records a dependency_added event for dep on its
+// source issue in eventTable, with the target as the new value.
+//
+//nolint:gosec // G201: eventTable is a hardcoded constant ("events" or "wisp_events")
+func RecordDependencyAddedInTx(ctx context.Context, tx *sql.Tx, eventTable string, dep *types.Dependency, actor string) error {
+	comment := fmt.Sprintf("Added dependency: %s (%s)", dep.DependsOnID, dep.Type)
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO %s (issue_id, event_type, actor, new_value, comment) VALUES (?, ?, ?, ?, ?)`, eventTable),
+		dep.IssueID, types.EventDependencyAdded, actor, dep.DependsOnID, comment); err != nil {
+		return fmt.Errorf("failed to record dependency event: %w", err)
+	}
+	return nil
+}
+
+// WouldCreateCycleError builds the storage.ErrWouldCreateCycle error for dep,
+// naming the cycle it would close (e.g. "a -> b -> c -> a").
+func WouldCreateCycleError(ctx context.Context, tx *sql.Tx, dep *types.Dependency, depTables []string) error {
+	path, err := FindBlockingPathInTx(ctx, tx, dep.DependsOnID, dep.IssueID, depTables)
+	if err != nil || len(path) == 0 {
+		return fmt.Errorf("%w: %s -> %s", storage.ErrWouldCreateCycle, dep.IssueID, dep.DependsOnID)
+	}
+	return fmt.Errorf("%w: %s", storage.ErrWouldCreateCycle, strings.Join(append([]string{dep.IssueID}, path...), " -> "))
+}
+
+// RemoveDependencyInTx deletes the issueID -> dependsOnID edge within an
+// existing transaction and records a dependency_removed event on the source
+// issue. Routes to wisp_dependencies/wisp_events if issueID is an active wisp.
+// Removing an edge that does not exist is a no-op.
+func RemoveDependencyInTx(ctx context.Context, tx *sql.Tx, issueID, dependsOnID, actor string) error {
+	_, _, eventTable, depTable := WispTableRouting(IsActiveWispInTx(ctx, tx, issueID))
+
+	//nolint:gosec // G201: depTable is from WispTableRouting
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE issue_id = ? AND depends_on_id = ?`, depTable),
+		issueID, dependsOnID)
+	if err != nil {
+		return fmt.Errorf("failed to remove dependency: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to remove dependency: rows affected: %w", err)
+	}
+	if n == 0 {
+		return nil
+	}
+
+	comment := fmt.Sprintf("Removed dependency: %s", dependsOnID)
+	//nolint:gosec // G201: eventTable is from WispTableRouting
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO %s (issue_id, event_type, actor, old_value, comment) VALUES (?, ?, ?, ?, ?)`, eventTable),
+		issueID, types.EventDependencyRemoved, actor, dependsOnID, comment); err != nil {
+		return fmt.Errorf("failed to record dependency event: %w", err)
+	}
 	return nil
 }
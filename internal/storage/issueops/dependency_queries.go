@@ -32,6 +32,138 @@ func GetAllDependencyRecordsInTx(ctx context.Context, tx *sql.Tx) (map[string][]
 	return result, rows.Err()
 }
 
+// GetDependenciesInTx returns the issues that issueID depends on, ordered by
+// priority then newest first. Routes to wisp_dependencies/wisps when issueID
+// is an active wisp. Targets that cannot be loaded (deleted issues, external
+// references) are omitted. Returns nil (not an error) when there are none.
+func GetDependenciesInTx(ctx context.Context, tx *sql.Tx, issueID string) ([]*types.Issue, error) {
+	return getLinkedIssuesInTx(ctx, tx, issueID, "issue_id", "depends_on_id", "get dependencies")
+}
+
+// GetDependentsInTx returns the issues that depend on issueID, ordered by
+// priority then newest first. Routes to wisp_dependencies/wisps when issueID
+// is an active wisp. Returns nil (not an error) when nothing depends on it.
+func GetDependentsInTx(ctx context.Context, tx *sql.Tx, issueID string) ([]*types.Issue, error) {
+	return getLinkedIssuesInTx(ctx, tx, issueID, "depends_on_id", "issue_id", "get dependents")
+}
+
+// getLinkedIssuesInTx loads the issues named by targetColumn on dependency
+// rows where matchColumn = issueID. op prefixes error messages.
+func getLinkedIssuesInTx(ctx context.Context, tx *sql.Tx, issueID, matchColumn, targetColumn, op string) ([]*types.Issue, error) {
+	isWisp := IsActiveWispInTx(ctx, tx, issueID)
+	issueTable, _, _, depTable := WispTableRouting(isWisp)
+
+	//nolint:gosec // G201: tables are from WispTableRouting, columns are hardcoded by callers
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT i.id FROM %s i
+		JOIN %s d ON i.id = d.%s
+		WHERE d.%s = ?
+		ORDER BY i.priority ASC, i.created_at DESC
+	`, issueTable, depTable, targetColumn, matchColumn), issueID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("%s: scan: %w", op, err)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows: %w", op, err)
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return GetIssuesInOrderInTx(ctx, tx, ids)
+}
+
+// GetDependenciesWithMetadataInTx returns the issues that issueID depends on,
+// each paired with the dependency type. Routes to wisp_dependencies when
+// issueID is an active wisp. Targets that cannot be loaded (deleted issues,
+// external references) are omitted, matching DoltStore.
+func GetDependenciesWithMetadataInTx(ctx context.Context, tx *sql.Tx, issueID string) ([]*types.IssueWithDependencyMetadata, error) {
+	return getIssuesWithDependencyMetadataInTx(ctx, tx, issueID, "issue_id", "depends_on_id")
+}
+
+// GetDependentsWithMetadataInTx returns the issues that depend on issueID,
+// each paired with the dependency type. Routing and omission rules match
+// GetDependenciesWithMetadataInTx.
+func GetDependentsWithMetadataInTx(ctx context.Context, tx *sql.Tx, issueID string) ([]*types.IssueWithDependencyMetadata, error) {
+	return getIssuesWithDependencyMetadataInTx(ctx, tx, issueID, "depends_on_id", "issue_id")
+}
+
+// getIssuesWithDependencyMetadataInTx scans the dependency rows where
+// matchColumn = issueID, then batch-loads the issues named by targetColumn.
+func getIssuesWithDependencyMetadataInTx(ctx context.Context, tx *sql.Tx, issueID, matchColumn, targetColumn string) ([]*types.IssueWithDependencyMetadata, error) {
+	_, _, _, depTable := WispTableRouting(IsActiveWispInTx(ctx, tx, issueID))
+
+	//nolint:gosec // G201: depTable is from WispTableRouting, columns are hardcoded by callers
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT %s, type FROM %s WHERE %s = ?
+	`, targetColumn, depTable, matchColumn), issueID)
+	if err != nil {
+		return nil, fmt.Errorf("get dependency metadata: %w", err)
+	}
+
+	type depMeta struct {
+		targetID, depType string
+	}
+	var deps []depMeta
+	for rows.Next() {
+		var d depMeta
+		if err := rows.Scan(&d.targetID, &d.depType); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("get dependency metadata: scan: %w", err)
+		}
+		deps = append(deps, d)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get dependency metadata: rows: %w", err)
+	}
+
+	if len(deps) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(deps))
+	for i, d := range deps {
+		ids[i] = d.targetID
+	}
+	issues, err := GetIssuesByIDsInTx(ctx, tx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get dependency metadata: %w", err)
+	}
+	issueMap := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		issueMap[issue.ID] = issue
+	}
+
+	var results []*types.IssueWithDependencyMetadata
+	for _, d := range deps {
+		issue, ok := issueMap[d.targetID]
+		if !ok {
+			continue
+		}
+		results = append(results, &types.IssueWithDependencyMetadata{
+			Issue:          *issue,
+			DependencyType: types.DependencyType(d.depType),
+		})
+	}
+	return results, nil
+}
+
 // GetDependencyRecordsForIssuesInTx returns dependency records for specific issues,
 // routing each ID to dependencies or wisp_dependencies based on wisp status.
 // Uses batched IN clauses (queryBatchSize) to avoid query-planner spikes.
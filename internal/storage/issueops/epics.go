@@ -0,0 +1,144 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// GetEpicsEligibleForClosureInTx returns the open epics that have at least one
+// parent-child child, with their closed/total child counts. An epic is
+// EligibleForClose when every child is closed. Child statuses are read from
+// both the issues and wisps tables (bd-w2w).
+//
+// Uses separate single-table queries rather than JOINs to avoid Dolt's
+// joinIter panic (join_iters.go:192).
+func GetEpicsEligibleForClosureInTx(ctx context.Context, tx *sql.Tx) ([]*types.EpicStatus, error) {
+	epicRows, err := tx.QueryContext(ctx, `
+		SELECT id FROM issues
+		WHERE issue_type = 'epic'
+		  AND status != 'closed'
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("get epics eligible for closure: epics: %w", err)
+	}
+	var epicIDs []string
+	epicSet := make(map[string]bool)
+	for epicRows.Next() {
+		var id string
+		if err := epicRows.Scan(&id); err != nil {
+			_ = epicRows.Close()
+			return nil, fmt.Errorf("get epics eligible for closure: scan epic: %w", err)
+		}
+		epicIDs = append(epicIDs, id)
+		epicSet[id] = true
+	}
+	_ = epicRows.Close()
+	if err := epicRows.Err(); err != nil {
+		return nil, fmt.Errorf("get epics eligible for closure: epic rows: %w", err)
+	}
+	if len(epicIDs) == 0 {
+		return nil, nil
+	}
+
+	depRows, err := tx.QueryContext(ctx, `
+		SELECT depends_on_id, issue_id FROM dependencies
+		WHERE type = 'parent-child'
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("get epics eligible for closure: parent-child deps: %w", err)
+	}
+	epicChildMap := make(map[string][]string)
+	var allChildIDs []string
+	for depRows.Next() {
+		var parentID, childID string
+		if err := depRows.Scan(&parentID, &childID); err != nil {
+			_ = depRows.Close()
+			return nil, fmt.Errorf("get epics eligible for closure: scan dep: %w", err)
+		}
+		if epicSet[parentID] {
+			epicChildMap[parentID] = append(epicChildMap[parentID], childID)
+			allChildIDs = append(allChildIDs, childID)
+		}
+	}
+	_ = depRows.Close()
+	if err := depRows.Err(); err != nil {
+		return nil, fmt.Errorf("get epics eligible for closure: dep rows: %w", err)
+	}
+
+	childStatus := make(map[string]string, len(allChildIDs))
+	for _, table := range []string{"issues", "wisps"} {
+		for start := 0; start < len(allChildIDs); start += queryBatchSize {
+			end := start + queryBatchSize
+			if end > len(allChildIDs) {
+				end = len(allChildIDs)
+			}
+			batch := allChildIDs[start:end]
+			args := make([]any, len(batch))
+			for i, id := range batch {
+				args[i] = id
+			}
+			//nolint:gosec // G201: table is hardcoded, placeholders contains only ? markers
+			rows, err := tx.QueryContext(ctx, fmt.Sprintf(`SELECT id, status FROM %s WHERE id IN (%s)`,
+				table, strings.TrimSuffix(strings.Repeat("?,", len(batch)), ",")), args...)
+			if err != nil {
+				if isTableNotExistError(err) {
+					break // wisps table may not exist on pre-migration databases (GH#2271)
+				}
+				return nil, fmt.Errorf("get epics eligible for closure: child statuses from %s: %w", table, err)
+			}
+			for rows.Next() {
+				var id, status string
+				if err := rows.Scan(&id, &status); err != nil {
+					_ = rows.Close()
+					return nil, fmt.Errorf("get epics eligible for closure: scan child status: %w", err)
+				}
+				childStatus[id] = status
+			}
+			_ = rows.Close()
+			if err := rows.Err(); err != nil {
+				return nil, fmt.Errorf("get epics eligible for closure: child status rows: %w", err)
+			}
+		}
+	}
+
+	var withChildren []string
+	for _, id := range epicIDs {
+		if len(epicChildMap[id]) > 0 {
+			withChildren = append(withChildren, id)
+		}
+	}
+	epics, err := GetIssuesByIDsInTx(ctx, tx, withChildren)
+	if err != nil {
+		return nil, fmt.Errorf("get epics eligible for closure: %w", err)
+	}
+	epicByID := make(map[string]*types.Issue, len(epics))
+	for _, epic := range epics {
+		epicByID[epic.ID] = epic
+	}
+
+	var results []*types.EpicStatus
+	for _, id := range withChildren {
+		epic, ok := epicByID[id]
+		if !ok {
+			continue
+		}
+		children := epicChildMap[id]
+		closed := 0
+		for _, childID := range children {
+			if types.Status(childStatus[childID]) == types.StatusClosed {
+				closed++
+			}
+		}
+		results = append(results, &types.EpicStatus{
+			Epic:             epic,
+			TotalChildren:    len(children),
+			ClosedChildren:   closed,
+			EligibleForClose: closed == len(children),
+		})
+	}
+	return results, nil
+}
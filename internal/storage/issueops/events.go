@@ -0,0 +1,100 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// GetEventsInTx returns the events for a single issue, newest first. Routes
+// to wisp_events if the ID is an active wisp. A limit <= 0 returns all events.
+func GetEventsInTx(ctx context.Context, tx *sql.Tx, issueID string, limit int) ([]*types.Event, error) {
+	_, _, eventTable, _ := WispTableRouting(IsActiveWispInTx(ctx, tx, issueID))
+
+	//nolint:gosec // G201: eventTable is from WispTableRouting
+	query := fmt.Sprintf(`
+		SELECT id, issue_id, event_type, actor, old_value, new_value, comment, created_at
+		FROM %s
+		WHERE issue_id = ?
+		ORDER BY created_at DESC
+	`, eventTable)
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("get events for %s: %w", issueID, err)
+	}
+	defer rows.Close()
+	events, err := scanEvents(rows)
+	if err != nil {
+		return nil, fmt.Errorf("get events for %s: %w", issueID, err)
+	}
+	return events, nil
+}
+
+// GetAllEventsSinceInTx returns all events created after since from both the
+// events and wisp_events tables, ordered by creation time ascending (ties
+// broken by ID) so a consumer can checkpoint on the last CreatedAt it saw.
+// Event IDs are UUIDs and cannot serve as a high-water mark themselves.
+func GetAllEventsSinceInTx(ctx context.Context, tx *sql.Tx, since time.Time) ([]*types.Event, error) {
+	var events []*types.Event
+	for _, table := range []string{"events", "wisp_events"} {
+		//nolint:gosec // G201: table is hardcoded
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+			SELECT id, issue_id, event_type, actor, old_value, new_value, comment, created_at
+			FROM %s
+			WHERE created_at > ?
+		`, table), since)
+		if err != nil {
+			if isTableNotExistError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("get events since %v from %s: %w", since, table, err)
+		}
+		tableEvents, err := scanEvents(rows)
+		_ = rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("get events since %v from %s: %w", since, table, err)
+		}
+		events = append(events, tableEvents...)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].CreatedAt.Equal(events[j].CreatedAt) {
+			return events[i].CreatedAt.Before(events[j].CreatedAt)
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events, nil
+}
+
+// scanEvents scans event rows selected as (id, issue_id, event_type, actor,
+// old_value, new_value, comment, created_at).
+func scanEvents(rows *sql.Rows) ([]*types.Event, error) {
+	var events []*types.Event
+	for rows.Next() {
+		var event types.Event
+		var oldValue, newValue, comment sql.NullString
+		if err := rows.Scan(&event.ID, &event.IssueID, &event.EventType, &event.Actor,
+			&oldValue, &newValue, &comment, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan event: %w", err)
+		}
+		if oldValue.Valid {
+			event.OldValue = &oldValue.String
+		}
+		if newValue.Valid {
+			event.NewValue = &newValue.String
+		}
+		if comment.Valid {
+			event.Comment = &comment.String
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
@@ -36,3 +37,125 @@ func GetIssueInTx(ctx context.Context, tx *sql.Tx, id string) (*types.Issue, err
 
 	return issue, nil
 }
+
+// GetIssueByExternalRefInTx retrieves an issue by external reference within an
+// existing transaction, using the idx_issues_external_ref index. Returns
+// storage.ErrNotFound (wrapped) if no issue has that external reference.
+func GetIssueByExternalRefInTx(ctx context.Context, tx *sql.Tx, externalRef string) (*types.Issue, error) {
+	var id string
+	err := tx.QueryRowContext(ctx, "SELECT id FROM issues WHERE external_ref = ?", externalRef).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: external_ref %s", storage.ErrNotFound, externalRef)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get issue by external_ref: %w", err)
+	}
+	return GetIssueInTx(ctx, tx, id)
+}
+
+// GetIssuesByIDsInTx retrieves multiple issues by ID within an existing
+// transaction, including their labels. IDs are partitioned between the issues
+// and wisps tables, and each table is queried with batched IN clauses
+// (queryBatchSize). IDs that exist in neither table are silently skipped.
+// Rows are returned in arbitrary order; callers that need a specific order
+// must reorder the result themselves.
+func GetIssuesByIDsInTx(ctx context.Context, tx *sql.Tx, ids []string) ([]*types.Issue, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var wispIDs, permIDs []string
+	for _, id := range ids {
+		if IsActiveWispInTx(ctx, tx, id) {
+			wispIDs = append(wispIDs, id)
+		} else {
+			permIDs = append(permIDs, id)
+		}
+	}
+
+	var issues []*types.Issue
+	for _, pair := range []struct {
+		table string
+		ids   []string
+	}{
+		{"wisps", wispIDs},
+		{"issues", permIDs},
+	} {
+		if len(pair.ids) == 0 {
+			continue
+		}
+		for start := 0; start < len(pair.ids); start += queryBatchSize {
+			end := start + queryBatchSize
+			if end > len(pair.ids) {
+				end = len(pair.ids)
+			}
+			batch := pair.ids[start:end]
+			placeholders := make([]string, len(batch))
+			args := make([]any, len(batch))
+			for i, id := range batch {
+				placeholders[i] = "?"
+				args[i] = id
+			}
+			//nolint:gosec // G201: pair.table is hardcoded
+			rows, err := tx.QueryContext(ctx, fmt.Sprintf(
+				`SELECT %s FROM %s WHERE id IN (%s)`,
+				IssueSelectColumns, pair.table, strings.Join(placeholders, ",")), args...)
+			if err != nil {
+				return nil, fmt.Errorf("get issues by IDs from %s: %w", pair.table, err)
+			}
+			for rows.Next() {
+				issue, scanErr := ScanIssueFrom(rows)
+				if scanErr != nil {
+					_ = rows.Close()
+					return nil, fmt.Errorf("get issues by IDs: scan: %w", scanErr)
+				}
+				issues = append(issues, issue)
+			}
+			_ = rows.Close()
+			if err := rows.Err(); err != nil {
+				return nil, fmt.Errorf("get issues by IDs: rows: %w", err)
+			}
+		}
+	}
+
+	// Hydrate labels in bulk after closing the result sets, so we don't hold
+	// multiple active result sets on the same connection.
+	if len(issues) > 0 {
+		found := make([]string, len(issues))
+		for i, issue := range issues {
+			found[i] = issue.ID
+		}
+		labelMap, err := GetLabelsForIssuesInTx(ctx, tx, found)
+		if err != nil {
+			return nil, fmt.Errorf("get issues by IDs: hydrate labels: %w", err)
+		}
+		for _, issue := range issues {
+			if labels, ok := labelMap[issue.ID]; ok {
+				issue.Labels = labels
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+// GetIssuesInOrderInTx is like GetIssuesByIDsInTx but returns the issues in
+// the same order as ids, dropping IDs that were not found. Use this when ids
+// came from a query with a meaningful ORDER BY. (GH#1880)
+func GetIssuesInOrderInTx(ctx context.Context, tx *sql.Tx, ids []string) ([]*types.Issue, error) {
+	issues, err := GetIssuesByIDsInTx(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	issueByID := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		issueByID[issue.ID] = issue
+	}
+	ordered := make([]*types.Issue, 0, len(ids))
+	for _, id := range ids {
+		if issue, ok := issueByID[id]; ok {
+			ordered = append(ordered, issue)
+		}
+	}
+	return ordered, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/steveyegge/beads/internal/types"
@@ -127,3 +128,66 @@ func AddLabelInTx(ctx context.Context, tx *sql.Tx, labelTable, eventTable, issue
 	}
 	return nil
 }
+
+// RemoveLabelInTx removes a label from an issue and records an event within
+// an existing transaction. Automatically routes to wisp tables if the ID is
+// an active wisp. Removing a label the issue does not have is a no-op apart
+// from the event.
+func RemoveLabelInTx(ctx context.Context, tx *sql.Tx, issueID, label, actor string) error {
+	_, labelTable, eventTable, _ := WispTableRouting(IsActiveWispInTx(ctx, tx, issueID))
+	//nolint:gosec // G201: labelTable is from WispTableRouting ("labels" or "wisp_labels")
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE issue_id = ? AND label = ?`, labelTable), issueID, label); err != nil {
+		return fmt.Errorf("remove label: %w", err)
+	}
+	comment := "Removed label: " + label
+	//nolint:gosec // G201: eventTable is from WispTableRouting ("events" or "wisp_events")
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO %s (issue_id, event_type, actor, comment) VALUES (?, ?, ?, ?)`, eventTable),
+		issueID, types.EventLabelRemoved, actor, comment); err != nil {
+		return fmt.Errorf("remove label: record event: %w", err)
+	}
+	return nil
+}
+
+// GetIssuesByLabelInTx returns all issues and wisps carrying label, ordered
+// by priority ascending then newest first. The label tables are read on their
+// own and the issues loaded in a batch, so no JOIN is needed.
+func GetIssuesByLabelInTx(ctx context.Context, tx *sql.Tx, label string) ([]*types.Issue, error) {
+	var ids []string
+	for _, labelTable := range []string{"labels", "wisp_labels"} {
+		//nolint:gosec // G201: labelTable is hardcoded
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf(`SELECT issue_id FROM %s WHERE label = ?`, labelTable), label)
+		if err != nil {
+			if isTableNotExistError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("get issues by label from %s: %w", labelTable, err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return nil, fmt.Errorf("get issues by label: scan: %w", err)
+			}
+			ids = append(ids, id)
+		}
+		_ = rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("get issues by label: rows from %s: %w", labelTable, err)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	issues, err := GetIssuesByIDsInTx(ctx, tx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get issues by label: %w", err)
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Priority != issues[j].Priority {
+			return issues[i].Priority < issues[j].Priority
+		}
+		return issues[i].CreatedAt.After(issues[j].CreatedAt)
+	})
+	return issues, nil
+}
@@ -0,0 +1,104 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// GetMoleculeProgressInTx returns progress stats for a molecule: how many of
+// its direct parent-child steps exist, are closed, and are in progress.
+// Routes to wisps/wisp_dependencies when the molecule is an active wisp;
+// children of a wisp molecule are also wisps. Uses single-table queries to
+// avoid Dolt's joinIter panic.
+func GetMoleculeProgressInTx(ctx context.Context, tx *sql.Tx, moleculeID string) (*types.MoleculeProgressStats, error) {
+	stats := &types.MoleculeProgressStats{
+		MoleculeID: moleculeID,
+	}
+
+	issueTable, _, _, depTable := WispTableRouting(IsActiveWispInTx(ctx, tx, moleculeID))
+
+	var title sql.NullString
+	//nolint:gosec // G201: issueTable is from WispTableRouting
+	err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT title FROM %s WHERE id = ?", issueTable), moleculeID).Scan(&title)
+	if err == nil && title.Valid {
+		stats.MoleculeTitle = title.String
+	}
+
+	//nolint:gosec // G201: depTable is from WispTableRouting
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT issue_id FROM %s
+		WHERE depends_on_id = ? AND type = 'parent-child'
+	`, depTable), moleculeID)
+	if err != nil {
+		return nil, fmt.Errorf("get molecule progress: children: %w", err)
+	}
+	var childIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("get molecule progress: scan child: %w", err)
+		}
+		childIDs = append(childIDs, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get molecule progress: children rows: %w", err)
+	}
+
+	statusByID := make(map[string]types.Status, len(childIDs))
+	for start := 0; start < len(childIDs); start += queryBatchSize {
+		end := start + queryBatchSize
+		if end > len(childIDs) {
+			end = len(childIDs)
+		}
+		batch := childIDs[start:end]
+		placeholders := make([]string, len(batch))
+		args := make([]any, len(batch))
+		for i, id := range batch {
+			placeholders[i] = "?"
+			args[i] = id
+		}
+		//nolint:gosec // G201: issueTable is from WispTableRouting, placeholders contains only ? markers
+		statusRows, err := tx.QueryContext(ctx, fmt.Sprintf(
+			"SELECT id, status FROM %s WHERE id IN (%s)", issueTable, strings.Join(placeholders, ",")), args...)
+		if err != nil {
+			return nil, fmt.Errorf("get molecule progress: child statuses: %w", err)
+		}
+		for statusRows.Next() {
+			var id, status string
+			if err := statusRows.Scan(&id, &status); err != nil {
+				_ = statusRows.Close()
+				return nil, fmt.Errorf("get molecule progress: scan status: %w", err)
+			}
+			statusByID[id] = types.Status(status)
+		}
+		_ = statusRows.Close()
+		if err := statusRows.Err(); err != nil {
+			return nil, fmt.Errorf("get molecule progress: status rows: %w", err)
+		}
+	}
+
+	for _, childID := range childIDs {
+		status, ok := statusByID[childID]
+		if !ok {
+			continue
+		}
+		stats.Total++
+		switch status {
+		case types.StatusClosed:
+			stats.Completed++
+		case types.StatusInProgress:
+			stats.InProgress++
+			if stats.CurrentStepID == "" {
+				stats.CurrentStepID = childID
+			}
+		}
+	}
+
+	return stats, nil
+}
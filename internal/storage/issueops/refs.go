@@ -0,0 +1,51 @@
+package issueops
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// validRefPattern matches valid Dolt commit hashes (32 hex chars) or branch names.
+// Allows dots and slashes for branch names like "release/v2.0" or "feature/auth.flow".
+var validRefPattern = regexp.MustCompile(`^[a-zA-Z0-9_./-]+$`)
+
+// ValidateRef checks if a ref is safe to interpolate into queries such as
+// AS OF and DOLT_DIFF, which do not accept placeholders.
+func ValidateRef(ref string) error {
+	if ref == "" {
+		return fmt.Errorf("ref cannot be empty")
+	}
+	if len(ref) > 128 {
+		return fmt.Errorf("ref too long")
+	}
+	if !validRefPattern.MatchString(ref) {
+		return fmt.Errorf("invalid ref format: %s", ref)
+	}
+	return nil
+}
+
+// asOfTimeLayouts are the timestamp forms AsOfExpr accepts in place of a ref.
+var asOfTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// AsOfExpr returns the SQL expression to put after AS OF for ref, which may
+// be a commit hash, a branch name, or a timestamp (RFC 3339, "2006-01-02
+// 15:04:05", or "2006-01-02"; zone-less forms are read as UTC). Timestamps
+// are wrapped in TIMESTAMP() because Dolt resolves a bare string as a commit
+// ref. The result is safe to interpolate.
+func AsOfExpr(ref string) (string, error) {
+	for _, layout := range asOfTimeLayouts {
+		if t, err := time.Parse(layout, ref); err == nil {
+			return fmt.Sprintf("TIMESTAMP('%s')", t.UTC().Format("2006-01-02 15:04:05.999999")), nil
+		}
+	}
+	if err := ValidateRef(ref); err != nil {
+		return "", err
+	}
+	return "'" + ref + "'", nil
+}
@@ -0,0 +1,59 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// GetStaleIssuesInTx returns non-ephemeral issues that have not been updated
+// in filter.Days days, oldest first. With no filter.Status, only open and
+// in_progress issues are considered.
+func GetStaleIssuesInTx(ctx context.Context, tx *sql.Tx, filter types.StaleFilter) ([]*types.Issue, error) {
+	cutoff := time.Now().UTC().AddDate(0, 0, -filter.Days)
+
+	statusClause := "status IN ('open', 'in_progress')"
+	args := []any{cutoff}
+	if filter.Status != "" {
+		statusClause = "status = ?"
+		args = append(args, filter.Status)
+	}
+
+	//nolint:gosec // G201: statusClause contains only literal SQL or a single ? placeholder
+	query := fmt.Sprintf(`
+		SELECT id FROM issues
+		WHERE updated_at < ?
+		  AND %s
+		  AND (ephemeral = 0 OR ephemeral IS NULL)
+		ORDER BY updated_at ASC
+	`, statusClause)
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get stale issues: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("get stale issues: scan: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get stale issues: rows: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return GetIssuesInOrderInTx(ctx, tx, ids)
+}
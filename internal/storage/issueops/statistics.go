@@ -0,0 +1,33 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// CountIssuesByStatusInTx returns the number of issues in each status,
+// including custom statuses. Statuses with no issues are absent from the map.
+func CountIssuesByStatusInTx(ctx context.Context, tx *sql.Tx) (map[types.Status]int64, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT status, COUNT(*) FROM issues GROUP BY status`)
+	if err != nil {
+		return nil, fmt.Errorf("count issues by status: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[types.Status]int64)
+	for rows.Next() {
+		var status string
+		var count int64
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("count issues by status: scan: %w", err)
+		}
+		counts[types.Status(status)] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("count issues by status: rows: %w", err)
+	}
+	return counts, nil
+}
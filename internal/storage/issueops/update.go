@@ -0,0 +1,214 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// UpdateIssueInTx updates fields on an issue within an existing transaction
+// and records an event describing the change. Routes to the wisps and
+// wisp_events tables if the ID is an active wisp. Returns storage.ErrNotFound
+// (wrapped) if the issue does not exist.
+//
+// Moving an issue between the issues and wisps tables is not handled here;
+// callers must route "wisp" and "no_history" updates themselves.
+func UpdateIssueInTx(ctx context.Context, tx *sql.Tx, id string, updates map[string]interface{}, actor string) error {
+	if rawMeta, ok := updates["metadata"]; ok {
+		metadataStr, err := storage.NormalizeMetadataValue(rawMeta)
+		if err != nil {
+			return fmt.Errorf("invalid metadata: %w", err)
+		}
+		if err := ValidateMetadataIfConfigured(json.RawMessage(metadataStr)); err != nil {
+			return err
+		}
+	}
+
+	issueTable, _, eventTable, _ := WispTableRouting(IsActiveWispInTx(ctx, tx, id))
+
+	// Read inside the transaction to avoid a TOCTOU race.
+	//nolint:gosec // G201: issueTable is from WispTableRouting ("issues" or "wisps")
+	row := tx.QueryRowContext(ctx, fmt.Sprintf(`SELECT %s FROM %s WHERE id = ?`, IssueSelectColumns, issueTable), id)
+	oldIssue, err := ScanIssueFrom(row)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("%w: issue %s", storage.ErrNotFound, id)
+	}
+	if err != nil {
+		return fmt.Errorf("update issue: get %s: %w", id, err)
+	}
+
+	setClauses := []string{"updated_at = ?"}
+	args := []interface{}{time.Now().UTC()}
+
+	for key, value := range updates {
+		if !IsAllowedUpdateField(key) {
+			return fmt.Errorf("invalid field for update: %s", key)
+		}
+
+		columnName := key
+		if key == "wisp" {
+			columnName = "ephemeral"
+		}
+		setClauses = append(setClauses, fmt.Sprintf("`%s` = ?", columnName))
+
+		// Array and JSON fields are stored as TEXT.
+		switch key {
+		case "waiters":
+			waitersJSON, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("invalid waiters: %w", err)
+			}
+			args = append(args, string(waitersJSON))
+		case "metadata":
+			metadataStr, err := storage.NormalizeMetadataValue(value)
+			if err != nil {
+				return fmt.Errorf("invalid metadata: %w", err)
+			}
+			args = append(args, metadataStr)
+		default:
+			args = append(args, value)
+		}
+	}
+
+	// Clear the legacy pinned column when status moves away from "pinned",
+	// so the issue does not disappear from bd list.
+	if newStatus, ok := updates["status"]; ok {
+		if oldIssue.Pinned && newStatus != "pinned" {
+			if _, alreadySet := updates["pinned"]; !alreadySet {
+				setClauses = append(setClauses, "`pinned` = ?")
+				args = append(args, false)
+			}
+		}
+	}
+
+	setClauses, args = ManageClosedAt(oldIssue, updates, setClauses, args)
+	args = append(args, id)
+
+	//nolint:gosec // G201: setClauses contains only column names, values are passed via args
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", issueTable, strings.Join(setClauses, ", "))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("update issue %s: %w", id, err)
+	}
+
+	oldData, _ := json.Marshal(oldIssue)
+	newData, _ := json.Marshal(updates)
+	eventType := DetermineEventType(oldIssue, updates)
+
+	//nolint:gosec // G201: eventTable is from WispTableRouting ("events" or "wisp_events")
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO %s (issue_id, event_type, actor, old_value, new_value)
+		VALUES (?, ?, ?, ?, ?)
+	`, eventTable), id, eventType, actor, string(oldData), string(newData)); err != nil {
+		return fmt.Errorf("update issue: record event: %w", err)
+	}
+	if err := RecordAssigneeChangeInTx(ctx, tx, eventTable, id, actor, oldIssue, updates); err != nil {
+		return fmt.Errorf("update issue: %w", err)
+	}
+	return nil
+}
+
+// RecordAssigneeChangeInTx records an assignee_changed event in eventTable
+// when updates sets a different assignee than oldIssue has, with the old and
+// new assignee as the event values. It is a no-op otherwise.
+//
+//nolint:gosec // G201: eventTable is a hardcoded constant ("events" or "wisp_events")
+func RecordAssigneeChangeInTx(ctx context.Context, tx *sql.Tx, eventTable, id, actor string, oldIssue *types.Issue, updates map[string]interface{}) error {
+	newAssignee, ok := updates["assignee"].(string)
+	if !ok || newAssignee == oldIssue.Assignee {
+		return nil
+	}
+
+	var comment string
+	switch {
+	case oldIssue.Assignee == "":
+		comment = "Assigned to " + newAssignee
+	case newAssignee == "":
+		comment = "Unassigned from " + oldIssue.Assignee
+	default:
+		comment = fmt.Sprintf("Reassigned from %s to %s", oldIssue.Assignee, newAssignee)
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO %s (issue_id, event_type, actor, old_value, new_value, comment)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, eventTable), id, types.EventAssigneeChanged, actor, oldIssue.Assignee, newAssignee, comment); err != nil {
+		return fmt.Errorf("record assignee change: %w", err)
+	}
+	return nil
+}
+
+// IsAllowedUpdateField reports whether key may be set through UpdateIssue.
+func IsAllowedUpdateField(key string) bool {
+	allowed := map[string]bool{
+		"status": true, "priority": true, "title": true, "assignee": true,
+		"description": true, "design": true, "acceptance_criteria": true, "notes": true,
+		"issue_type": true, "estimated_minutes": true, "external_ref": true, "spec_id": true,
+		"closed_at": true, "close_reason": true, "closed_by_session": true,
+		"source_repo": true,
+		"sender":      true, "wisp": true, "wisp_type": true, "no_history": true, "pinned": true,
+		"hook_bead": true, "role_bead": true, "agent_state": true, "last_activity": true,
+		"role_type": true, "rig": true, "mol_type": true, "holder": true,
+		"event_category": true, "event_actor": true, "event_target": true, "event_payload": true,
+		"due_at": true, "defer_until": true, "await_id": true, "waiters": true,
+		"metadata": true,
+	}
+	return allowed[key]
+}
+
+// ManageClosedAt appends closed_at (and close_reason) clauses for a status
+// change: closed_at is set when closing and cleared when reopening. An
+// explicit closed_at in updates takes precedence.
+func ManageClosedAt(oldIssue *types.Issue, updates map[string]interface{}, setClauses []string, args []interface{}) ([]string, []interface{}) {
+	statusVal, hasStatus := updates["status"]
+	_, hasExplicitClosedAt := updates["closed_at"]
+	if hasExplicitClosedAt || !hasStatus {
+		return setClauses, args
+	}
+
+	var newStatus string
+	switch v := statusVal.(type) {
+	case string:
+		newStatus = v
+	case types.Status:
+		newStatus = string(v)
+	default:
+		return setClauses, args
+	}
+
+	if newStatus == string(types.StatusClosed) {
+		now := time.Now().UTC()
+		setClauses = append(setClauses, "closed_at = ?")
+		args = append(args, now)
+	} else if oldIssue.Status == types.StatusClosed {
+		setClauses = append(setClauses, "closed_at = ?", "close_reason = ?")
+		args = append(args, nil, "")
+	}
+
+	return setClauses, args
+}
+
+// DetermineEventType picks the event type recorded for an update.
+func DetermineEventType(oldIssue *types.Issue, updates map[string]interface{}) types.EventType {
+	statusVal, hasStatus := updates["status"]
+	if !hasStatus {
+		return types.EventUpdated
+	}
+
+	newStatus, ok := statusVal.(string)
+	if !ok {
+		return types.EventUpdated
+	}
+
+	if newStatus == string(types.StatusClosed) {
+		return types.EventClosed
+	}
+	if oldIssue.Status == types.StatusClosed {
+		return types.EventReopened
+	}
+	return types.EventStatusChanged
+}
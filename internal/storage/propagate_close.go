@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// PropagateClose closes the parent epics of a just-closed issue once all of
+// their children are closed, then repeats for the parents of each epic it
+// closed, so completion ripples up nested epics. Every parent-child parent is
+// followed, not just the first. Eligibility comes from
+// GetEpicsEligibleForClosure, so only open epics with every child closed are
+// touched and re-running after everything is closed is a no-op.
+//
+// Returns the IDs of the epics it closed, in the order they were closed. On
+// error, the IDs closed before the failure are still returned.
+func PropagateClose(ctx context.Context, s Storage, closedIssueID, actor, session string) ([]string, error) {
+	var closed []string
+	visited := map[string]bool{closedIssueID: true}
+	frontier := []string{closedIssueID}
+	for len(frontier) > 0 {
+		var parents []string
+		for _, id := range frontier {
+			deps, err := s.GetDependenciesWithMetadata(ctx, id)
+			if err != nil {
+				return closed, fmt.Errorf("getting parents of %s: %w", id, err)
+			}
+			for _, dep := range deps {
+				if dep.DependencyType != types.DepParentChild || visited[dep.ID] {
+					continue
+				}
+				visited[dep.ID] = true
+				parents = append(parents, dep.ID)
+			}
+		}
+		if len(parents) == 0 {
+			return closed, nil
+		}
+
+		statuses, err := s.GetEpicsEligibleForClosure(ctx)
+		if err != nil {
+			return closed, fmt.Errorf("getting epics eligible for closure: %w", err)
+		}
+		eligible := make(map[string]bool, len(statuses))
+		for _, status := range statuses {
+			if status.EligibleForClose {
+				eligible[status.Epic.ID] = true
+			}
+		}
+
+		frontier = frontier[:0]
+		for _, parentID := range parents {
+			if !eligible[parentID] {
+				continue
+			}
+			if err := s.CloseIssue(ctx, parentID, "All children completed", actor, session); err != nil {
+				return closed, fmt.Errorf("closing epic %s: %w", parentID, err)
+			}
+			closed = append(closed, parentID)
+			frontier = append(frontier, parentID)
+		}
+	}
+	return closed, nil
+}
@@ -9,6 +9,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/steveyegge/beads/internal/types"
@@ -25,9 +27,43 @@ var ErrNotFound = errors.New("not found")
 // (e.g., issue_prefix config is missing).
 var ErrNotInitialized = errors.New("database not initialized")
 
+// ErrWouldCreateCycle is returned when adding a blocking dependency would close
+// a cycle. The wrapped error message contains the offending path.
+var ErrWouldCreateCycle = errors.New("adding dependency would create a cycle")
+
 // ErrPrefixMismatch is returned when an issue ID does not match the configured prefix.
 var ErrPrefixMismatch = errors.New("prefix mismatch")
 
+// ErrUnsupportedFilter is returned when a query filter sets a field that the
+// storage backend does not implement, rather than silently ignoring it.
+var ErrUnsupportedFilter = errors.New("unsupported filter")
+
+// CheckReadyWorkFilter rejects WorkFilter fields that GetReadyWork does not
+// implement, so callers get an error instead of unfiltered results.
+func CheckReadyWorkFilter(filter types.WorkFilter) error {
+	if filter.LabelPattern != "" {
+		return fmt.Errorf("%w: ready work does not support label patterns", ErrUnsupportedFilter)
+	}
+	if filter.LabelRegex != "" {
+		return fmt.Errorf("%w: ready work does not support label regexes", ErrUnsupportedFilter)
+	}
+	return nil
+}
+
+// MissingIssuesError is returned by bulk operations when some of the requested
+// issues do not exist. It matches ErrNotFound with errors.Is.
+type MissingIssuesError struct {
+	IDs []string
+}
+
+func (e *MissingIssuesError) Error() string {
+	return fmt.Sprintf("%s: %d issue(s): %s", ErrNotFound, len(e.IDs), strings.Join(e.IDs, ", "))
+}
+
+func (e *MissingIssuesError) Unwrap() error {
+	return ErrNotFound
+}
+
 // Storage is the interface satisfied by *dolt.DoltStore.
 // Consumers depend on this interface rather than on the concrete type so that
 // alternative implementations (mocks, proxies, etc.) can be substituted.
@@ -40,6 +76,7 @@ type Storage interface {
 	GetIssuesByIDs(ctx context.Context, ids []string) ([]*types.Issue, error)
 	UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error
 	CloseIssue(ctx context.Context, id string, reason string, actor string, session string) error
+	CloseIssues(ctx context.Context, ids []string, reason string, actor string, session string) error
 	DeleteIssue(ctx context.Context, id string) error
 	SearchIssues(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error)
 
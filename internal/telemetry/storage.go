@@ -151,6 +151,17 @@ func (s *InstrumentedStorage) CloseIssue(ctx context.Context, id string, reason
 	return err
 }
 
+func (s *InstrumentedStorage) CloseIssues(ctx context.Context, ids []string, reason string, actor string, session string) error {
+	attrs := []attribute.KeyValue{
+		attribute.Int("bd.issue.count", len(ids)),
+		attribute.String("bd.actor", actor),
+	}
+	ctx, span, t := s.op(ctx, "CloseIssues", attrs...)
+	err := s.inner.CloseIssues(ctx, ids, reason, actor, session)
+	s.done(ctx, span, t, err, attrs...)
+	return err
+}
+
 func (s *InstrumentedStorage) DeleteIssue(ctx context.Context, id string) error {
 	attrs := []attribute.KeyValue{attribute.String("bd.issue.id", id)}
 	ctx, span, t := s.op(ctx, "DeleteIssue", attrs...)
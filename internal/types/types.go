@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash"
+	"slices"
 	"strings"
 	"time"
 )
@@ -868,6 +869,7 @@ const (
 	EventLabelAdded        EventType = "label_added"
 	EventLabelRemoved      EventType = "label_removed"
 	EventCompacted         EventType = "compacted"
+	EventAssigneeChanged   EventType = "assignee_changed"
 )
 
 // BlockedIssue extends Issue with blocking information
@@ -918,6 +920,10 @@ type Statistics struct {
 	PinnedIssues            int     `json:"pinned_issues"` // Persistent issues
 	EpicsEligibleForClosure int     `json:"epics_eligible_for_closure"`
 	AverageLeadTime         float64 `json:"average_lead_time_hours"`
+
+	// ByStatus holds the issue count for every status in use, including
+	// custom statuses.
+	ByStatus map[Status]int64 `json:"by_status,omitempty"`
 }
 
 // IssueFilter is used to filter issue queries
@@ -1042,6 +1048,12 @@ type WorkFilter struct {
 	Limit        int
 	SortPolicy   SortPolicy
 
+	// Priority floor, type allow-list, and label exclusion for agents that
+	// should only pick up certain work (e.g., skip "needs-human").
+	Types         []string // OR semantics: issue type must be one of these (combined with Type)
+	PriorityMax   *int     // Only issues at this priority or more urgent (P0 is most urgent)
+	ExcludeLabels []string // Skip issues that have ANY of these labels
+
 	// Parent filtering: filter to descendants of a bead/epic (recursive)
 	ParentID *string // Show all descendants of this issue
 
@@ -1064,6 +1076,51 @@ type WorkFilter struct {
 	HasMetadataKey string            // Existence check: issue has this top-level key set (non-null)
 }
 
+// Matches reports whether an already-loaded issue satisfies the type,
+// priority, assignee, and label fields of the filter. issue.Labels must be
+// populated for the label checks to be meaningful. Status, parent, deferral,
+// ephemeral, molecule/wisp type, and metadata filtering are applied by the
+// storage query and are not evaluated here. Label patterns are not supported
+// for ready work.
+func (f WorkFilter) Matches(issue *Issue) bool {
+	if f.Type != "" && string(issue.IssueType) != f.Type {
+		return false
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, string(issue.IssueType)) {
+		return false
+	}
+	if f.Priority != nil && issue.Priority != *f.Priority {
+		return false
+	}
+	if f.PriorityMax != nil && issue.Priority > *f.PriorityMax {
+		return false
+	}
+	// Unassigned takes precedence over Assignee
+	if f.Unassigned {
+		if issue.Assignee != "" {
+			return false
+		}
+	} else if f.Assignee != nil && issue.Assignee != *f.Assignee {
+		return false
+	}
+	for _, label := range f.Labels {
+		if !slices.Contains(issue.Labels, label) {
+			return false
+		}
+	}
+	if len(f.LabelsAny) > 0 && !slices.ContainsFunc(f.LabelsAny, func(label string) bool {
+		return slices.Contains(issue.Labels, label)
+	}) {
+		return false
+	}
+	if slices.ContainsFunc(f.ExcludeLabels, func(label string) bool {
+		return slices.Contains(issue.Labels, label)
+	}) {
+		return false
+	}
+	return true
+}
+
 // StaleFilter is used to filter stale issue queries
 type StaleFilter struct {
 	Days   int    // Issues not updated in this many days
@@ -1424,3 +1424,47 @@ func TestComputeContentHashWithValidations(t *testing.T) {
 		t.Error("Expected different hash when Score is added")
 	}
 }
+
+func TestWorkFilterMatches(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+	strPtr := func(s string) *string { return &s }
+
+	bug := &Issue{ID: "bd-1", IssueType: TypeBug, Priority: 1, Assignee: "alice", Labels: []string{"backend"}}
+	chore := &Issue{ID: "bd-2", IssueType: TypeChore, Priority: 3, Labels: []string{"backend", "needs-human"}}
+
+	tests := []struct {
+		name   string
+		filter WorkFilter
+		issue  *Issue
+		want   bool
+	}{
+		{"empty filter", WorkFilter{}, bug, true},
+		{"priority floor below", WorkFilter{PriorityMax: intPtr(2)}, bug, true},
+		{"priority floor equal", WorkFilter{PriorityMax: intPtr(1)}, bug, true},
+		{"priority floor above", WorkFilter{PriorityMax: intPtr(0)}, bug, false},
+		{"priority floor zero excludes p3", WorkFilter{PriorityMax: intPtr(0)}, chore, false},
+		{"exact priority", WorkFilter{Priority: intPtr(3)}, chore, true},
+		{"exact priority mismatch", WorkFilter{Priority: intPtr(3)}, bug, false},
+		{"types allow-list hit", WorkFilter{Types: []string{"task", "bug"}}, bug, true},
+		{"types allow-list miss", WorkFilter{Types: []string{"task", "bug"}}, chore, false},
+		{"type and types must both match", WorkFilter{Type: "bug", Types: []string{"chore"}}, bug, false},
+		{"assignee match", WorkFilter{Assignee: strPtr("alice")}, bug, true},
+		{"assignee mismatch", WorkFilter{Assignee: strPtr("bob")}, bug, false},
+		{"unassigned", WorkFilter{Unassigned: true}, chore, true},
+		{"unassigned overrides assignee", WorkFilter{Unassigned: true, Assignee: strPtr("alice")}, bug, false},
+		{"labels all", WorkFilter{Labels: []string{"backend", "needs-human"}}, chore, true},
+		{"labels all missing one", WorkFilter{Labels: []string{"backend", "needs-human"}}, bug, false},
+		{"labels any", WorkFilter{LabelsAny: []string{"frontend", "backend"}}, bug, true},
+		{"labels any none", WorkFilter{LabelsAny: []string{"frontend"}}, bug, false},
+		{"exclude labels hit", WorkFilter{ExcludeLabels: []string{"needs-human"}}, chore, false},
+		{"exclude labels miss", WorkFilter{ExcludeLabels: []string{"needs-human"}}, bug, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(tt.issue); got != tt.want {
+				t.Errorf("Matches(%s) = %v, want %v", tt.issue.ID, got, tt.want)
+			}
+		})
+	}
+}